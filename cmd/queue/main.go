@@ -5,17 +5,23 @@ import (
 	"github.com/meschbach/elevatinator/pkg/controllers/queue"
 	"github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/srv"
 	"github.com/spf13/cobra"
+	"log/slog"
 	"os"
 )
 
 func main() {
 	serviceAddress := "localhost:9998"
+	verbose := false
 
 	run := &cobra.Command{
 		Use:   "run",
 		Short: "launches the serivce",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return srv.RunControllerService(queue.NewController, srv.ListenAt(serviceAddress))
+			options := []srv.Option{srv.ListenAt(serviceAddress)}
+			if verbose {
+				options = append(options, srv.WithLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+			}
+			return srv.RunControllerService(queue.NewController, options...)
 		},
 	}
 
@@ -24,6 +30,7 @@ func main() {
 		Short: "Elevatinator AI unit using a queueing technique",
 	}
 	rootCmd.PersistentFlags().StringVarP(&serviceAddress, "address", "a", serviceAddress, "Binding address for runs")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", verbose, "Logs diagnostic output to stderr")
 	rootCmd.AddCommand(run)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -2,8 +2,12 @@ package main
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/meschbach/elevatinator/pkg/eventio"
 	"github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy"
 	"github.com/meschbach/elevatinator/pkg/scenarios"
 	"github.com/meschbach/elevatinator/pkg/simulator"
@@ -12,21 +16,86 @@ import (
 
 func main() {
 	serviceAddress := "localhost:9998"
+	verbose := false
+	eventsOut := ""
+	reportFormat := "text"
+	reportOut := ""
 
-	runScenario := func(use string, short string, setup func(simulation *simulator.Simulation) simulator.Tick) *cobra.Command {
-		return &cobra.Command{
-			Use:   use,
-			Short: short,
-			RunE: func(cmd *cobra.Command, args []string) error {
-				bridge, err := telepathy.DialLanding(serviceAddress)
-				if err != nil {
+	type builtin struct {
+		use   string
+		short string
+		setup scenarios.Scenario
+	}
+	builtins := []builtin{
+		{"single-up", "Runs a scenario for a single person to go up", scenarios.SinglePersonUp},
+		{"single-down", "Runs a scenario for a single person to go down", scenarios.SinglePersonDown},
+		{"multiple-up-and-back", "Runs a scenario with various persons going up and back", scenarios.MultipleUpAndBack},
+		{"basement-commute", "Runs a scenario with persons travelling between basement parking and an office tower", scenarios.BasementCommute},
+		{"day-in-the-life", "Runs a scenario with tenants arriving for work, heading out for lunch and leaving for the day", scenarios.DayInTheLife},
+		{"mixed-crowd", "Runs a scenario with visitors, tenants and couriers each going about the building in their own way", scenarios.MixedCrowd},
+		{"freight-and-passengers", "Runs a scenario with freight, carts and wheelchair users sharing a load-rated service car", scenarios.FreightAndPassengers},
+		{"stuck-between-floors", "Runs a scenario where a person is trapped by an elevator breakdown", scenarios.StuckBetweenFloors},
+	}
+
+	// eventsFileFor names the events file of the scenario.  When several scenarios run together each receives its own
+	// file, suffixing the scenario's name ahead of the extension, so the runs are not mixed together.
+	eventsFileFor := func(name string, several bool) string {
+		if !several {
+			return eventsOut
+		}
+		ext := filepath.Ext(eventsOut)
+		return strings.TrimSuffix(eventsOut, ext) + "-" + name + ext
+	}
+
+	// runScenarios runs each scenario against the AI service in turn, reporting the results once all have run.  An error
+	// is returned when any scenario fails, exiting non-zero for scripts and continuous integration.
+	runScenarios := func(selected []builtin) error {
+		reporter, err := scenarios.ReporterFor(reportFormat)
+		if err != nil {
+			return err
+		}
+		logger := slog.New(slog.DiscardHandler)
+		if verbose {
+			logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		}
+		bridge, err := telepathy.DialLanding(serviceAddress, telepathy.WithLogger(logger))
+		if err != nil {
+			return err
+		}
+		results := make([]scenarios.Result, 0, len(selected))
+		for _, b := range selected {
+			name, setup := b.use, b.setup
+			var scenario scenarios.Scenario = func(simulation *simulator.Simulation) simulator.Tick {
+				simulation.SetLogger(logger.With("scenario", name))
+				return setup(simulation)
+			}
+			var events *eventio.Writer
+			if eventsOut != "" {
+				if events, err = eventio.Create(eventsFileFor(name, len(selected) > 1)); err != nil {
 					return err
 				}
-
-				scenarios.RunScenario(bridge.ControllerAdapter(), setup)
-				return nil
-			},
+				scenario = scenarios.Observed(scenario, events)
+			}
+			results = append(results, scenarios.RunScenario(bridge.ControllerAdapter(), scenario, scenarios.Named(name), scenarios.WithEventLog()))
+			if events != nil {
+				if err := events.Close(); err != nil {
+					return err
+				}
+			}
+		}
+		if err := scenarios.ReportTo(reporter, reportOut, results); err != nil {
+			return err
+		}
+		failed := 0
+		for _, result := range results {
+			if !result.Completed {
+				failed++
+			}
 		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d scenarios failed", failed, len(results))
+		}
+		return nil
 	}
 
 	rootCmd := &cobra.Command{
@@ -34,9 +103,28 @@ func main() {
 		Short: "Run scenarios against an AI gRPC service",
 	}
 	rootCmd.PersistentFlags().StringVarP(&serviceAddress, "ai-address", "a", serviceAddress, "AI unit address to connect to")
-	rootCmd.AddCommand(runScenario("single-up", "Runs a scenario for a single person to go up", scenarios.SinglePersonUp))
-	rootCmd.AddCommand(runScenario("single-down", "Runs a scenario for a single person to go down", scenarios.SinglePersonDown))
-	rootCmd.AddCommand(runScenario("multiple-up-and-back", "Runs a scenario with various persons going up and back", scenarios.MultipleUpAndBack))
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", verbose, "Logs diagnostic output to stderr")
+	rootCmd.PersistentFlags().StringVar(&eventsOut, "events-out", eventsOut, "Writes the events of the run to the file, formatted by its extension: .jsonl, .csv or .pb; with several scenarios each is written to its own file suffixed by the scenario's name")
+	rootCmd.PersistentFlags().StringVar(&reportFormat, "report", reportFormat, "Format of the report of the runs: text, json or junit")
+	rootCmd.PersistentFlags().StringVar(&reportOut, "report-out", reportOut, "Writes the report to the file rather than stdout")
+	for _, b := range builtins {
+		rootCmd.AddCommand(&cobra.Command{
+			Use:   b.use,
+			Short: b.short,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				cmd.SilenceUsage = true
+				return runScenarios([]builtin{b})
+			},
+		})
+	}
+	rootCmd.AddCommand(&cobra.Command{
+		Use:   "all",
+		Short: "Runs every scenario, reporting the results together",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return runScenarios(builtins)
+		},
+	})
 	rootCmd.AddCommand(healthProbeCommand(&serviceAddress))
 
 	if err := rootCmd.Execute(); err != nil {
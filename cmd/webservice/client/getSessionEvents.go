@@ -16,10 +16,23 @@ type GetSessionEventsReplyEvents struct {
 	EventType string `json:"eventType"`
 	Timestamp *int64 `json:"timestamp,omitempty"`
 
-	Entity   *simulator.EntityID   `json:"entity,omitempty"`
-	Elevator *simulator.ElevatorID `json:"elevator,omitempty"`
-	Floor    *simulator.FloorID    `json:"floor,omitempty"`
-	Points   *int                  `json:"points,omitempty"`
+	Entity    *simulator.EntityID   `json:"entity,omitempty"`
+	Elevator  *simulator.ElevatorID `json:"elevator,omitempty"`
+	Floor     *simulator.FloorID    `json:"floor,omitempty"`
+	Points    *int                  `json:"points,omitempty"`
+	Direction *string               `json:"direction,omitempty"`
+	// FloorLabel is the building's name for Floor, present whenever Floor is.
+	FloorLabel *string `json:"floorLabel,omitempty"`
+	// Fault is the reason an elevator was taken out of service.
+	Fault *string `json:"fault,omitempty"`
+}
+
+// floorName prefers the building's label for the event's floor, falling back to the floor index.
+func (e GetSessionEventsReplyEvents) floorName() string {
+	if e.FloorLabel != nil {
+		return *e.FloorLabel
+	}
+	return fmt.Sprintf("%d", *e.Floor)
 }
 
 func (c *webClient) GetSessionEvents(ctx context.Context, sessionID string) (*GetSessionEventsReply, error) {
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+type GetSessionScoreReply struct {
+	Actors int `json:"actors"`
+	// Legs counts the legs of every actor's itinerary, with completed and abandoned counting legs.
+	Legs      int                       `json:"legs"`
+	Completed int                       `json:"completed"`
+	Abandoned int                       `json:"abandoned"`
+	Wait      GetSessionScoreReplyStats `json:"wait"`
+	Ride      GetSessionScoreReplyStats `json:"ride"`
+	Journey   GetSessionScoreReplyStats `json:"journey"`
+	Energy    float64                   `json:"energy"`
+	// ElevatorEnergy is the energy consumed by each elevator, indexed by elevator.
+	ElevatorEnergy   []float64 `json:"elevatorEnergy"`
+	ControllerFaults int       `json:"controllerFaults"`
+	Disqualified     bool      `json:"disqualified"`
+}
+
+type GetSessionScoreReplyStats struct {
+	Count   int            `json:"count"`
+	Average float64        `json:"average"`
+	Max     simulator.Tick `json:"max"`
+	P95     simulator.Tick `json:"p95"`
+}
+
+func (c *webClient) GetSessionScore(ctx context.Context, sessionID string) (*GetSessionScoreReply, error) {
+	url := fmt.Sprintf("/session/%s/score", sessionID)
+	req, err := c.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := &GetSessionScoreReply{}
+	if err := c.Do(req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
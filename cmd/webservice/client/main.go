@@ -33,7 +33,7 @@ func safeSession(ctx context.Context, log io.Writer, baseURL string) error {
 			fmt.Fprintf(log, "panic: %s", err)
 		}
 	}()
-	
+
 	fmt.Printf("Using base URL %q\n", baseURL)
 	client := newWebClient(baseURL)
 	scenarios, err := client.GetScenarios(ctx)
@@ -82,26 +82,40 @@ func safeSession(ctx context.Context, log io.Writer, baseURL string) error {
 		case "InitDone":
 			fmt.Printf("\t*** Init done.\n")
 		case "InformFloor":
-			fmt.Printf("\tFloor %d\n", *e.Floor)
+			fmt.Printf("\tFloor %s\n", e.floorName())
 		case "InformElevator":
 			fmt.Printf("\tElevator %d\n", *e.Elevator)
 		case "TickStart":
 			fmt.Printf("\tTick Start %d\n", *e.Timestamp)
 		case "ElevatorCalled":
-			fmt.Printf("\t\tElevator called on floor %d\n", *e.Floor)
+			fmt.Printf("\t\tElevator called on floor %s\n", e.floorName())
 		case "TickDone":
 			fmt.Printf("\tdone (%d)\n", *e.Timestamp)
 		case "ElevatorFloorRequest":
-			fmt.Printf("\t\tActor in elevator %d requesting floor %d\n", *e.Elevator, *e.Floor)
+			fmt.Printf("\t\tActor %d in elevator %d requesting floor %s\n", *e.Entity, *e.Elevator, e.floorName())
 		case "ActorFinished":
-			fmt.Printf("\t\tActor finshed.  Earned %d point(s).\n", *e.Points)
+			fmt.Printf("\t\tActor %d finshed on floor %s.  Earned %d point(s).\n", *e.Entity, e.floorName(), *e.Points)
+		case "ActorSpawned":
+			fmt.Printf("\t\tActor %d arrived on floor %s\n", *e.Entity, e.floorName())
+		case "ActorBoarded":
+			fmt.Printf("\t\tActor %d boarded elevator %d at floor %s\n", *e.Entity, *e.Elevator, e.floorName())
+		case "ActorExited":
+			fmt.Printf("\t\tActor %d exited elevator %d at floor %s\n", *e.Entity, *e.Elevator, e.floorName())
+		case "ActorTookStairs":
+			fmt.Printf("\t\tActor %d took the stairs to floor %s\n", *e.Entity, e.floorName())
 		case "ElevatorArrived":
-			fmt.Printf("\t\tElevator %d arrived at floor %d\n", *e.Elevator, *e.Floor)
+			fmt.Printf("\t\tElevator %d arrived at floor %s\n", *e.Elevator, e.floorName())
 		case "ElevatorAtFloor":
-			fmt.Printf("\t\tElevator %d is at floor %d\n", *e.Elevator, *e.Floor)
+			fmt.Printf("\t\tElevator %d is at floor %s\n", *e.Elevator, e.floorName())
 		default:
 			fmt.Printf("\t\tUnhandled event: %+v\n", e)
 		}
 	}
+
+	score, err := client.GetSessionScore(ctx, reply.SessionID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Score: %+v\n", score)
 	return nil
 }
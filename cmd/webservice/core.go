@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"sync"
 
@@ -30,6 +31,8 @@ type service struct {
 
 	state        *sync.RWMutex
 	gameSessions map[string]*gameSession
+	// logger receives diagnostic output of each session's simulation, annotated with the session ID.
+	logger *slog.Logger
 }
 
 type GetScenariosDescription struct {
@@ -101,18 +104,18 @@ func (s *service) postSessionRoute(ctx context.Context, r *http.Request) (httpRe
 		return unprocessableEntity("missing controller"), nil
 	}
 
-	//build the session
-	session, err := s.newGameSession(*requestBody.Scenario, *requestBody.Controller)
+	// generate ID
+	id, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
+	idString := id.String()
 
-	// generate ID
-	id, err := uuid.NewV7()
+	//build the session
+	session, err := s.newGameSession(idString, *requestBody.Scenario, *requestBody.Controller)
 	if err != nil {
 		return nil, err
 	}
-	idString := id.String()
 
 	// attach to the service
 	func() {
@@ -164,10 +167,15 @@ type GetSessionEventsReplyEvents struct {
 	EventType string          `json:"eventType"`
 	Timestamp *simulator.Tick `json:"timestamp,omitempty"`
 
-	Entity   *simulator.EntityID   `json:"entity,omitempty"`
-	Elevator *simulator.ElevatorID `json:"elevator,omitempty"`
-	Floor    *simulator.FloorID    `json:"floor,omitempty"`
-	Points   *int                  `json:"points,omitempty"`
+	Entity    *simulator.EntityID   `json:"entity,omitempty"`
+	Elevator  *simulator.ElevatorID `json:"elevator,omitempty"`
+	Floor     *simulator.FloorID    `json:"floor,omitempty"`
+	Points    *int                  `json:"points,omitempty"`
+	Direction *string               `json:"direction,omitempty"`
+	// FloorLabel is the building's name for Floor, present whenever Floor is.
+	FloorLabel *string `json:"floorLabel,omitempty"`
+	// Fault is the reason an elevator was taken out of service.
+	Fault *string `json:"fault,omitempty"`
 }
 
 func (s *service) getSessionEvents(ctx context.Context, r *http.Request) (httpReply, error) {
@@ -191,13 +199,15 @@ func (s *service) getSessionEvents(ctx context.Context, r *http.Request) (httpRe
 	events := session.eventLog.Events()
 	translated := make([]GetSessionEventsReplyEvents, len(events))
 	for index, event := range events {
+		translated[index].Timestamp = &event.Timestamp
+		if event.Entity != simulator.NoEntity {
+			translated[index].Entity = &event.Entity
+		}
 		switch event.EventType {
 		case simulator.TickStart:
 			translated[index].EventType = "TickStart"
-			translated[index].Timestamp = &event.Timestamp
 		case simulator.TickDone:
 			translated[index].EventType = "TickDone"
-			translated[index].Timestamp = &event.Timestamp
 		case simulator.InitStart:
 			translated[index].EventType = "InitStart"
 		case simulator.InitDone:
@@ -211,6 +221,8 @@ func (s *service) getSessionEvents(ctx context.Context, r *http.Request) (httpRe
 		case simulator.ElevatorCalled:
 			translated[index].EventType = "ElevatorCalled"
 			translated[index].Floor = &event.Floor
+			direction := event.Direction.String()
+			translated[index].Direction = &direction
 		case simulator.ElevatorArrived:
 			translated[index].EventType = "ElevatorArrived"
 			translated[index].Floor = &event.Floor
@@ -221,17 +233,151 @@ func (s *service) getSessionEvents(ctx context.Context, r *http.Request) (httpRe
 			translated[index].Elevator = &event.Elevator
 		case simulator.ActorFinished:
 			translated[index].EventType = "ActorFinished"
+			translated[index].Floor = &event.Floor
 			translated[index].Points = &event.Points
 		case simulator.ElevatorAtFloor:
 			translated[index].EventType = "ElevatorAtFloor"
 			translated[index].Floor = &event.Floor
 			translated[index].Elevator = &event.Elevator
+		case simulator.ActorBoardingRejected:
+			translated[index].EventType = "ActorBoardingRejected"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.DoorsOpened:
+			translated[index].EventType = "DoorsOpened"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.DoorsClosed:
+			translated[index].EventType = "DoorsClosed"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ElevatorMoveDeferred:
+			translated[index].EventType = "ElevatorMoveDeferred"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ActorAbandoned:
+			translated[index].EventType = "ActorAbandoned"
+			translated[index].Floor = &event.Floor
+		case simulator.ElevatorMoveRejected:
+			translated[index].EventType = "ElevatorMoveRejected"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ElevatorOutOfService:
+			fault := event.Fault.String()
+			translated[index].EventType = "ElevatorOutOfService"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+			translated[index].Fault = &fault
+		case simulator.ElevatorRestored:
+			translated[index].EventType = "ElevatorRestored"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ElevatorStopServed:
+			translated[index].EventType = "ElevatorStopServed"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ActorSpawned:
+			translated[index].EventType = "ActorSpawned"
+			translated[index].Floor = &event.Floor
+		case simulator.ActorBoarded:
+			translated[index].EventType = "ActorBoarded"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ActorExited:
+			translated[index].EventType = "ActorExited"
+			translated[index].Floor = &event.Floor
+			translated[index].Elevator = &event.Elevator
+		case simulator.ActorTookStairs:
+			translated[index].EventType = "ActorTookStairs"
+			translated[index].Floor = &event.Floor
+		case simulator.ControllerFault:
+			translated[index].EventType = "ControllerFault"
+			if event.Elevator != simulator.NoElevator {
+				translated[index].Elevator = &event.Elevator
+			}
+			if event.Floor != simulator.NoFloor {
+				translated[index].Floor = &event.Floor
+			}
 		default:
 			translated[index].EventType = fmt.Sprintf("%s", event.ToString())
 		}
+		if translated[index].Floor != nil {
+			translated[index].FloorLabel = &event.FloorLabel
+		}
 	}
 
 	return OkJSON(GetSessionEventsReply{
 		Events: translated,
 	}), nil
 }
+
+type GetSessionScoreReply struct {
+	Actors int `json:"actors"`
+	// Legs counts the legs of every actor's itinerary, with completed and abandoned counting legs.
+	Legs      int                       `json:"legs"`
+	Completed int                       `json:"completed"`
+	Abandoned int                       `json:"abandoned"`
+	Wait      GetSessionScoreReplyStats `json:"wait"`
+	Ride      GetSessionScoreReplyStats `json:"ride"`
+	Journey   GetSessionScoreReplyStats `json:"journey"`
+	Energy    float64                   `json:"energy"`
+	// ElevatorEnergy is the energy consumed by each elevator, indexed by elevator.
+	ElevatorEnergy   []float64 `json:"elevatorEnergy"`
+	ControllerFaults int       `json:"controllerFaults"`
+	Disqualified     bool      `json:"disqualified"`
+}
+
+type GetSessionScoreReplyStats struct {
+	Count   int            `json:"count"`
+	Average float64        `json:"average"`
+	Max     simulator.Tick `json:"max"`
+	P95     simulator.Tick `json:"p95"`
+}
+
+func scoreStatsToWire(stat simulator.Statistic) GetSessionScoreReplyStats {
+	return GetSessionScoreReplyStats{
+		Count:   stat.Count,
+		Average: stat.Average,
+		Max:     stat.Max,
+		P95:     stat.P95,
+	}
+}
+
+func (s *service) getSessionScore(ctx context.Context, r *http.Request) (httpReply, error) {
+	pathVariables := mux.Vars(r)
+	id := pathVariables["sessionID"]
+
+	session := func() *gameSession {
+		s.state.RLock()
+		defer s.state.RUnlock()
+
+		session := s.gameSessions[id]
+		return session
+	}()
+
+	if session == nil {
+		return notFound(fmt.Sprintf("session %q not found", id)), nil
+	}
+
+	score, elevatorEnergy, err := session.score()
+	if err != nil {
+		return nil, err
+	}
+	wireEnergy := make([]float64, len(elevatorEnergy))
+	for i, e := range elevatorEnergy {
+		wireEnergy[i] = float64(e)
+	}
+	return OkJSON(GetSessionScoreReply{
+		Actors:           score.Actors,
+		Legs:             score.Legs,
+		Completed:        score.Completed,
+		Abandoned:        score.Abandoned,
+		Wait:             scoreStatsToWire(score.Wait),
+		Ride:             scoreStatsToWire(score.Ride),
+		Journey:          scoreStatsToWire(score.Journey),
+		Energy:           float64(score.Energy),
+		ElevatorEnergy:   wireEnergy,
+		ControllerFaults: score.ControllerFaults,
+		Disqualified:     score.Disqualified,
+	}), nil
+}
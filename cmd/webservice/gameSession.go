@@ -15,7 +15,7 @@ type gameSession struct {
 	eventLog   *gameSessionLog
 }
 
-func (s *service) newGameSession(scenarioName string, aiName string) (*gameSession, error) {
+func (s *service) newGameSession(sessionID string, scenarioName string, aiName string) (*gameSession, error) {
 	s.state.RLock()
 	defer s.state.RUnlock()
 
@@ -35,6 +35,7 @@ func (s *service) newGameSession(scenarioName string, aiName string) (*gameSessi
 	log := &gameSessionLog{}
 
 	sim := simulator.NewSimulation()
+	sim.SetLogger(s.logger.With("session", sessionID))
 	sim.AttachControllerListener(log)
 	matchedScenario[0].setup(sim)
 	sim.AttachControllerFunc(matchedAIUnits[0].Controller)
@@ -59,6 +60,21 @@ func (g *gameSession) tick() (done bool, problem error) {
 	return g.isDone, nil
 }
 
+func (g *gameSession) score() (simulator.Score, []simulator.Energy, error) {
+	g.state.RLock()
+	defer g.state.RUnlock()
+
+	elevatorEnergy := make([]simulator.Energy, g.simulation.ElevatorCount())
+	for i := range elevatorEnergy {
+		energy, err := g.simulation.ElevatorEnergy(simulator.ElevatorID(i))
+		if err != nil {
+			return simulator.Score{}, nil, err
+		}
+		elevatorEnergy[i] = energy
+	}
+	return g.simulation.Score(), elevatorEnergy, nil
+}
+
 type gameSessionLogMarker int
 
 type gameSessionLog struct {
@@ -2,17 +2,23 @@ package main
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 
 	"github.com/spf13/cobra"
 )
 
 func main() {
+	verbose := false
 	runCommand := &cobra.Command{
 		Use:   "run",
 		Short: "Runs a webservice to control and maintain interactions",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			runService(cmd.Context())
+			logger := slog.New(slog.DiscardHandler)
+			if verbose {
+				logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			}
+			runService(cmd.Context(), logger)
 			return nil
 		},
 	}
@@ -21,6 +27,7 @@ func main() {
 		Use:   "webservice",
 		Short: "Runs the webservice for online visualization and interaction",
 	}
+	runCommand.Flags().BoolVarP(&verbose, "verbose", "v", verbose, "Logs simulation diagnostics to stderr")
 	rootCmd.AddCommand(runCommand)
 
 	if err := rootCmd.Execute(); err != nil {
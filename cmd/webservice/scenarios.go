@@ -18,6 +18,22 @@ type DynamicScenarioWire struct {
 	Floors      int                        `json:"floors"`
 	Elevators   int                        `json:"elevators"`
 	Actors      []DynamicScenarioActorWire `json:"actors"`
+	// FloorLabels optionally names each floor from the lowest upwards, such as "B1", "L" and "1".
+	FloorLabels []string `json:"floor-labels,omitempty"`
+	// Lobby is the label of the lobby floor, defaulting to the lowest floor.
+	Lobby string `json:"lobby,omitempty"`
+}
+
+// building describes the floors of the scenario, numbering any floors which have not been labeled.
+func (d *DynamicScenarioWire) building() simulator.Building {
+	if len(d.FloorLabels) == 0 {
+		return simulator.NumberedBuilding(d.Floors)
+	}
+	building := simulator.Building{Floors: d.FloorLabels}
+	if lobby, ok := building.Floor(d.Lobby); ok {
+		building.Lobby = lobby
+	}
+	return building
 }
 
 func (d *DynamicScenarioWire) validate() string {
@@ -30,6 +46,25 @@ func (d *DynamicScenarioWire) validate() string {
 	if d.Elevators < 1 {
 		return "elevators must be at least 1"
 	}
+	if len(d.FloorLabels) > 0 && len(d.FloorLabels) != d.Floors {
+		return fmt.Sprintf("expected %d floor labels, got %d", d.Floors, len(d.FloorLabels))
+	}
+	building := d.building()
+	if err := building.Validate(); err != nil {
+		return err.Error()
+	}
+	if d.Lobby != "" {
+		if _, ok := building.Floor(d.Lobby); !ok {
+			return fmt.Sprintf("lobby %q is not a floor label", d.Lobby)
+		}
+	}
+	for _, actor := range d.Actors {
+		for _, label := range []string{actor.StartingFloorLabel, actor.GoalFloorLabel} {
+			if _, ok := building.Floor(label); label != "" && !ok {
+				return fmt.Sprintf("actor %q references unknown floor %q", actor.Name, label)
+			}
+		}
+	}
 	return ""
 }
 
@@ -38,6 +73,9 @@ type DynamicScenarioActorWire struct {
 	StartingFloor simulator.FloorID `json:"starting-floor"`
 	StartingTick  simulator.Tick    `json:"starting-tick"`
 	GoalFloor     simulator.FloorID `json:"goal-floor"`
+	// StartingFloorLabel and GoalFloorLabel name floors by label, taking precedence over the floor indexes.
+	StartingFloorLabel string `json:"starting-floor-label,omitempty"`
+	GoalFloorLabel     string `json:"goal-floor-label,omitempty"`
 }
 
 type PostScenarioRequestBody struct {
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -17,18 +18,24 @@ import (
 	"github.com/rs/cors"
 )
 
-func runService(processContext context.Context) {
+func runService(processContext context.Context, logger *slog.Logger) {
 	core := &service{
 		builtinScenarios: []scenario{
 			{Name: "single-up", Description: "a single person to go up", setup: scenarios.SinglePersonUp},
 			{Name: "single-down", Description: "a single person to go down", setup: scenarios.SinglePersonDown},
 			{Name: "multiple-up-and-back", Description: "various persons going up and back", setup: scenarios.MultipleUpAndBack},
+			{Name: "basement-commute", Description: "persons travelling between basement parking and an office tower", setup: scenarios.BasementCommute},
+			{Name: "day-in-the-life", Description: "tenants arriving for work, heading out for lunch and leaving for the day", setup: scenarios.DayInTheLife},
+			{Name: "mixed-crowd", Description: "visitors, tenants and couriers each going about the building in their own way", setup: scenarios.MixedCrowd},
+			{Name: "freight-and-passengers", Description: "freight, carts and wheelchair users sharing a load-rated service car", setup: scenarios.FreightAndPassengers},
+			{Name: "stuck-between-floors", Description: "a person trapped by an elevator breakdown", setup: scenarios.StuckBetweenFloors},
 		},
 		aiUnits: []aiUnits{
 			{Name: "queue", Controller: queue.NewController},
 		},
 		state:        &sync.RWMutex{},
 		gameSessions: make(map[string]*gameSession),
+		logger:       logger,
 	}
 
 	router := mux.NewRouter()
@@ -48,6 +55,7 @@ func runService(processContext context.Context) {
 	router.Path("/session").Methods(http.MethodPost).HandlerFunc(smartRoute(core.postSessionRoute))
 	router.Path("/session/{sessionID}/tick").Methods(http.MethodPost).HandlerFunc(smartRoute(core.postSessionTickRoute))
 	router.Path("/session/{sessionID}/events").Methods(http.MethodGet).HandlerFunc(smartRoute(core.getSessionEvents))
+	router.Path("/session/{sessionID}/score").Methods(http.MethodGet).HandlerFunc(smartRoute(core.getSessionScore))
 
 	router.HandleFunc("/real-time", realTimeSocketProc)
 	router.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -1,10 +1,53 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/meschbach/elevatinator/pkg/eventio"
 	"github.com/meschbach/elevatinator/pkg/scenarios"
 	"github.com/meschbach/elevatinator/pkg/simulator"
 )
 
 func main() {
-	scenarios.RunScenario(simulator.NewMoveController, scenarios.MultipleUpAndBack)
+	eventsOut := flag.String("events-out", "", "Writes the events of the run to the file, formatted by its extension: .jsonl, .csv or .pb")
+	reportFormat := flag.String("report", "text", "Format of the report of the run: text, json or junit")
+	reportOut := flag.String("report-out", "", "Writes the report to the file rather than stdout")
+	flag.Parse()
+
+	if err := run(*eventsOut, *reportFormat, *reportOut); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(eventsOut, reportFormat, reportOut string) error {
+	reporter, err := scenarios.ReporterFor(reportFormat)
+	if err != nil {
+		return err
+	}
+
+	scenario := scenarios.Scenario(scenarios.MultipleUpAndBack)
+	var events *eventio.Writer
+	if eventsOut != "" {
+		if events, err = eventio.Create(eventsOut); err != nil {
+			return err
+		}
+		scenario = scenarios.Observed(scenario, events)
+	}
+	result := scenarios.RunScenario(simulator.NewMoveController, scenario, scenarios.Named("multiple-up-and-back"), scenarios.WithEventLog())
+	if events != nil {
+		if err := events.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := scenarios.ReportTo(reporter, reportOut, []scenarios.Result{result}); err != nil {
+		return err
+	}
+	if !result.Completed {
+		return fmt.Errorf("scenario %s failed", result.Name)
+	}
+	return nil
 }
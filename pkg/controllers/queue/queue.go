@@ -1,7 +1,8 @@
 package queue
 
 import (
-	"fmt"
+	"log/slog"
+
 	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
 )
 
@@ -36,8 +37,8 @@ func (m *Controller) Init(elevators []simulator2.ElevatorID) {
 	m.id = elevators[0]
 }
 
-func (m *Controller) Called(floor simulator2.FloorID) {
-	fmt.Printf("Call at %d\n", floor)
+func (m *Controller) Called(floor simulator2.FloorID, direction simulator2.Direction) {
+	m.logger().Debug("call", "floor", floor, "direction", direction)
 	m.enqueueOrPerform(request{
 		requestType: ControllerPickingUpCall,
 		floor:       floor,
@@ -45,7 +46,7 @@ func (m *Controller) Called(floor simulator2.FloorID) {
 }
 
 func (m *Controller) FloorSelected(elevatorID simulator2.ElevatorID, floor simulator2.FloorID) {
-	fmt.Printf("Elevator call...state: %d\n", m.state)
+	m.logger().Debug("floor selected", "elevator", elevatorID, "floor", floor, "state", m.state)
 	m.enqueueOrPerform(request{
 		requestType: ControllerDroppingOff,
 		floor:       floor,
@@ -53,16 +54,21 @@ func (m *Controller) FloorSelected(elevatorID simulator2.ElevatorID, floor simul
 }
 
 func (m *Controller) CompletedMove(elevatorID simulator2.ElevatorID) {
-	fmt.Printf("Completed move to %d with %d pending\n", m.state, m.pending)
+	m.logger().Debug("completed move", "elevator", elevatorID, "state", m.state, "pending", len(m.pending))
 	m.dequeueOrIdle()
 }
 
 func (m *Controller) perform(what request) {
-	fmt.Printf("Performing %#v", what)
+	m.logger().Debug("performing", "request", what.requestType, "floor", what.floor)
 	m.state = what.requestType
 	m.elevator.MoveTo(m.id, what.floor)
 }
 
+// logger resolves on each use so entries carry the attributes, such as the tick, current at the time of logging.
+func (m *Controller) logger() *slog.Logger {
+	return simulator2.LoggerFor(m.elevator)
+}
+
 func (m *Controller) enqueueOrPerform(what request) {
 	if m.state == ControllerIdle {
 		m.perform(what)
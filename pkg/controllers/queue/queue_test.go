@@ -16,3 +16,23 @@ func TestSingleDown(t *testing.T) {
 func TestMultipleUpAndBack(t *testing.T) {
 	scenarios.TestScenario(t, NewController, scenarios.MultipleUpAndBack)
 }
+
+func TestBasementCommute(t *testing.T) {
+	scenarios.TestScenario(t, NewController, scenarios.BasementCommute)
+}
+
+func TestDayInTheLife(t *testing.T) {
+	scenarios.TestScenario(t, NewController, scenarios.DayInTheLife)
+}
+
+func TestMixedCrowd(t *testing.T) {
+	scenarios.TestScenario(t, NewController, scenarios.MixedCrowd)
+}
+
+func TestFreightAndPassengers(t *testing.T) {
+	scenarios.TestScenario(t, NewController, scenarios.FreightAndPassengers)
+}
+
+func TestStuckBetweenFloors(t *testing.T) {
+	scenarios.TestScenario(t, NewController, scenarios.StuckBetweenFloors)
+}
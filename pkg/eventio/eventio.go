@@ -0,0 +1,176 @@
+// Package eventio streams simulator events to and from files for analysis outside of the simulator.  Events may be
+// stored as JSON Lines, CSV, or length-delimited protobuf messages.
+package eventio
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/meschbach/elevatinator/pkg/eventio/pb"
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+// Format is an encoding for a stream of events.
+type Format int
+
+const (
+	// JSONLines writes each event as a JSON object on its own line.
+	JSONLines Format = iota
+	// CSV writes a header row followed by a row for each event.
+	CSV
+	// Protobuf writes each event as a pb.Event prefixed by its varint encoded length.
+	Protobuf
+)
+
+func (f Format) String() string {
+	switch f {
+	case JSONLines:
+		return "jsonl"
+	case CSV:
+		return "csv"
+	case Protobuf:
+		return "protobuf"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// FormatFor picks the format for a file by its extension: .jsonl or .ndjson for JSON Lines, .csv for CSV, and .pb or
+// .binpb for protobuf.
+func FormatFor(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return JSONLines, nil
+	case ".csv":
+		return CSV, nil
+	case ".pb", ".binpb":
+		return Protobuf, nil
+	default:
+		return -1, fmt.Errorf("unable to determine event format of %q, expected a .jsonl, .csv or .pb file", path)
+	}
+}
+
+// record is the representation of an event shared by all formats.  Names are used in place of enumerations so files
+// remain legible.
+type record struct {
+	EventType  string `json:"eventType"`
+	Timestamp  int64  `json:"timestamp"`
+	Entity     int64  `json:"entity"`
+	Elevator   int64  `json:"elevator"`
+	Floor      int64  `json:"floor"`
+	FloorLabel string `json:"floorLabel"`
+	Points     int64  `json:"points"`
+	Direction  string `json:"direction"`
+	Fault      string `json:"fault"`
+}
+
+// columns are the CSV header, in the order of the fields of a record.
+var columns = []string{"eventType", "timestamp", "entity", "elevator", "floor", "floorLabel", "points", "direction", "fault"}
+
+func toRecord(event simulator2.Event) record {
+	return record{
+		EventType:  event.EventType.String(),
+		Timestamp:  int64(event.Timestamp),
+		Entity:     int64(event.Entity),
+		Elevator:   int64(event.Elevator),
+		Floor:      int64(event.Floor),
+		FloorLabel: event.FloorLabel,
+		Points:     int64(event.Points),
+		Direction:  event.Direction.String(),
+		Fault:      event.Fault.String(),
+	}
+}
+
+func (r record) event() (simulator2.Event, error) {
+	eventType, ok := simulator2.ParseEventType(r.EventType)
+	if !ok {
+		return simulator2.Event{}, fmt.Errorf("unknown event type %q", r.EventType)
+	}
+	direction, ok := simulator2.ParseDirection(r.Direction)
+	if !ok {
+		return simulator2.Event{}, fmt.Errorf("unknown direction %q", r.Direction)
+	}
+	fault, ok := simulator2.ParseFaultKind(r.Fault)
+	if !ok {
+		return simulator2.Event{}, fmt.Errorf("unknown fault %q", r.Fault)
+	}
+	return simulator2.Event{
+		EventType:  eventType,
+		Timestamp:  simulator2.Tick(r.Timestamp),
+		Entity:     simulator2.EntityID(r.Entity),
+		Elevator:   simulator2.ElevatorID(r.Elevator),
+		Floor:      simulator2.FloorID(r.Floor),
+		Points:     int(r.Points),
+		Direction:  direction,
+		Fault:      fault,
+		FloorLabel: r.FloorLabel,
+	}, nil
+}
+
+func (r record) toWire() *pb.Event {
+	return &pb.Event{
+		EventType:  r.EventType,
+		Timestamp:  r.Timestamp,
+		Entity:     r.Entity,
+		Elevator:   r.Elevator,
+		Floor:      r.Floor,
+		FloorLabel: r.FloorLabel,
+		Points:     r.Points,
+		Direction:  r.Direction,
+		Fault:      r.Fault,
+	}
+}
+
+func recordFromWire(wire *pb.Event) record {
+	return record{
+		EventType:  wire.EventType,
+		Timestamp:  wire.Timestamp,
+		Entity:     wire.Entity,
+		Elevator:   wire.Elevator,
+		Floor:      wire.Floor,
+		FloorLabel: wire.FloorLabel,
+		Points:     wire.Points,
+		Direction:  wire.Direction,
+		Fault:      wire.Fault,
+	}
+}
+
+func (r record) row() []string {
+	return []string{
+		r.EventType,
+		strconv.FormatInt(r.Timestamp, 10),
+		strconv.FormatInt(r.Entity, 10),
+		strconv.FormatInt(r.Elevator, 10),
+		strconv.FormatInt(r.Floor, 10),
+		r.FloorLabel,
+		strconv.FormatInt(r.Points, 10),
+		r.Direction,
+		r.Fault,
+	}
+}
+
+func recordFromRow(row []string) (record, error) {
+	if len(row) != len(columns) {
+		return record{}, fmt.Errorf("expected %d columns, got %d", len(columns), len(row))
+	}
+	r := record{
+		EventType:  row[0],
+		FloorLabel: row[5],
+		Direction:  row[7],
+		Fault:      row[8],
+	}
+	numbers := []struct {
+		column int
+		into   *int64
+	}{{1, &r.Timestamp}, {2, &r.Entity}, {3, &r.Elevator}, {4, &r.Floor}, {6, &r.Points}}
+	for _, n := range numbers {
+		value, err := strconv.ParseInt(row[n.column], 10, 64)
+		if err != nil {
+			return record{}, fmt.Errorf("column %s: %w", columns[n.column], err)
+		}
+		*n.into = value
+	}
+	return r, nil
+}
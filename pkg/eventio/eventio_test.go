@@ -0,0 +1,89 @@
+package eventio
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/meschbach/elevatinator/pkg/scenarios"
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+// recordRun captures the events of a run exercising labeled floors, faults and hall call directions.
+func recordRun(t *testing.T) []simulator2.Event {
+	t.Helper()
+	events := scenarios.Record(simulator2.NewMoveController, scenarios.StuckBetweenFloors)
+	if len(events) == 0 {
+		t.Fatalf("Expected the run to produce events")
+	}
+	return events
+}
+
+func TestFormatsRoundTripEvents(t *testing.T) {
+	recorded := recordRun(t)
+	for _, format := range []Format{JSONLines, CSV, Protobuf} {
+		t.Run(format.String(), func(t *testing.T) {
+			var buffer bytes.Buffer
+			writer := NewWriter(&buffer, format)
+			for _, e := range recorded {
+				writer.OnControllerEvent(e)
+			}
+			if err := writer.Close(); err != nil {
+				t.Fatalf("Unable to write events: %s", err)
+			}
+
+			read, err := ReadAll(&buffer, format)
+			if err != nil {
+				t.Fatalf("Unable to read events: %s", err)
+			}
+			if len(read) != len(recorded) {
+				t.Fatalf("Expected %d events, read %d", len(recorded), len(read))
+			}
+			for i := range recorded {
+				if read[i] != recorded[i] {
+					t.Fatalf("Expected event %d to be %s, read %s", i, recorded[i].ToString(), read[i].ToString())
+				}
+			}
+		})
+	}
+}
+
+func TestCreatePicksFormatByExtension(t *testing.T) {
+	recorded := recordRun(t)
+	for _, name := range []string{"events.jsonl", "events.csv", "events.pb"} {
+		path := filepath.Join(t.TempDir(), name)
+		writer, err := Create(path)
+		if err != nil {
+			t.Fatalf("Unable to create %s: %s", name, err)
+		}
+		scenarios.RunScenario(simulator2.NewMoveController, scenarios.Observed(scenarios.StuckBetweenFloors, writer))
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Unable to write %s: %s", name, err)
+		}
+
+		read, err := ReadFile(path)
+		if err != nil {
+			t.Fatalf("Unable to read %s: %s", name, err)
+		}
+		if len(read) != len(recorded) {
+			t.Errorf("Expected %s to hold %d events, read %d", name, len(recorded), len(read))
+		}
+	}
+
+	if _, err := Create(filepath.Join(t.TempDir(), "events.txt")); err == nil {
+		t.Errorf("Expected an unknown extension to be refused")
+	}
+}
+
+func TestReaderReportsMalformedEvent(t *testing.T) {
+	input := "eventType,timestamp,entity,elevator,floor,floorLabel,points,direction,fault\n" +
+		"TickStart,0,-1,0,0,,0,none,none\n" +
+		"Teleported,1,-1,0,0,,0,none,none\n"
+	read, err := ReadAll(bytes.NewBufferString(input), CSV)
+	if err == nil {
+		t.Fatalf("Expected unknown event type to be reported")
+	}
+	if len(read) != 1 {
+		t.Errorf("Expected the events preceding the malformed event, got %d", len(read))
+	}
+}
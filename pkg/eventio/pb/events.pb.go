@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.17.3
+// source: pkg/eventio/pb/events.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Entity        int64                  `protobuf:"varint,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	Elevator      int64                  `protobuf:"varint,4,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Floor         int64                  `protobuf:"varint,5,opt,name=floor,proto3" json:"floor,omitempty"`
+	FloorLabel    string                 `protobuf:"bytes,6,opt,name=floorLabel,proto3" json:"floorLabel,omitempty"`
+	Points        int64                  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`
+	Direction     string                 `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"`
+	Fault         string                 `protobuf:"bytes,9,opt,name=fault,proto3" json:"fault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_pkg_eventio_pb_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_eventio_pb_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_pkg_eventio_pb_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Event) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Event) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+func (x *Event) GetElevator() int64 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *Event) GetFloor() int64 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Event) GetFloorLabel() string {
+	if x != nil {
+		return x.FloorLabel
+	}
+	return ""
+}
+
+func (x *Event) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Event) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Event) GetFault() string {
+	if x != nil {
+		return x.Fault
+	}
+	return ""
+}
+
+var File_pkg_eventio_pb_events_proto protoreflect.FileDescriptor
+
+const file_pkg_eventio_pb_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1bpkg/eventio/pb/events.proto\"\xf9\x01\n" +
+	"\x05Event\x12\x1c\n" +
+	"\teventType\x18\x01 \x01(\tR\teventType\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x16\n" +
+	"\x06entity\x18\x03 \x01(\x03R\x06entity\x12\x1a\n" +
+	"\belevator\x18\x04 \x01(\x03R\belevator\x12\x14\n" +
+	"\x05floor\x18\x05 \x01(\x03R\x05floor\x12\x1e\n" +
+	"\n" +
+	"floorLabel\x18\x06 \x01(\tR\n" +
+	"floorLabel\x12\x16\n" +
+	"\x06points\x18\a \x01(\x03R\x06points\x12\x1c\n" +
+	"\tdirection\x18\b \x01(\tR\tdirection\x12\x14\n" +
+	"\x05fault\x18\t \x01(\tR\x05faultB\fZ\n" +
+	"eventio/pbb\x06proto3"
+
+var (
+	file_pkg_eventio_pb_events_proto_rawDescOnce sync.Once
+	file_pkg_eventio_pb_events_proto_rawDescData []byte
+)
+
+func file_pkg_eventio_pb_events_proto_rawDescGZIP() []byte {
+	file_pkg_eventio_pb_events_proto_rawDescOnce.Do(func() {
+		file_pkg_eventio_pb_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_eventio_pb_events_proto_rawDesc), len(file_pkg_eventio_pb_events_proto_rawDesc)))
+	})
+	return file_pkg_eventio_pb_events_proto_rawDescData
+}
+
+var file_pkg_eventio_pb_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_eventio_pb_events_proto_goTypes = []any{
+	(*Event)(nil), // 0: Event
+}
+var file_pkg_eventio_pb_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_eventio_pb_events_proto_init() }
+func file_pkg_eventio_pb_events_proto_init() {
+	if File_pkg_eventio_pb_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_eventio_pb_events_proto_rawDesc), len(file_pkg_eventio_pb_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_eventio_pb_events_proto_goTypes,
+		DependencyIndexes: file_pkg_eventio_pb_events_proto_depIdxs,
+		MessageInfos:      file_pkg_eventio_pb_events_proto_msgTypes,
+	}.Build()
+	File_pkg_eventio_pb_events_proto = out.File
+	file_pkg_eventio_pb_events_proto_goTypes = nil
+	file_pkg_eventio_pb_events_proto_depIdxs = nil
+}
@@ -0,0 +1,122 @@
+package eventio
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/meschbach/elevatinator/pkg/eventio/pb"
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Reader decodes a stream of events produced by a Writer.
+type Reader struct {
+	format Format
+	in     *bufio.Reader
+	json   *json.Decoder
+	csv    *csv.Reader
+	// header is true once the CSV header has been verified.
+	header bool
+	// read is the number of events decoded so far, used to locate malformed events.
+	read int
+}
+
+// NewReader decodes events in the given format from in.
+func NewReader(in io.Reader, format Format) *Reader {
+	r := &Reader{format: format, in: bufio.NewReader(in)}
+	switch format {
+	case JSONLines:
+		r.json = json.NewDecoder(r.in)
+		r.json.DisallowUnknownFields()
+	case CSV:
+		r.csv = csv.NewReader(r.in)
+		r.csv.FieldsPerRecord = len(columns)
+	}
+	return r
+}
+
+// Next decodes the following event, returning io.EOF once all events have been read.
+func (r *Reader) Next() (simulator2.Event, error) {
+	rec, err := r.next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return simulator2.Event{}, io.EOF
+		}
+		return simulator2.Event{}, fmt.Errorf("event %d: %w", r.read, err)
+	}
+	event, err := rec.event()
+	if err != nil {
+		return simulator2.Event{}, fmt.Errorf("event %d: %w", r.read, err)
+	}
+	r.read++
+	return event, nil
+}
+
+func (r *Reader) next() (record, error) {
+	switch r.format {
+	case JSONLines:
+		var rec record
+		err := r.json.Decode(&rec)
+		return rec, err
+	case CSV:
+		if !r.header {
+			header, err := r.csv.Read()
+			if err != nil {
+				return record{}, err
+			}
+			if !slices.Equal(header, columns) {
+				return record{}, fmt.Errorf("unexpected header %v", header)
+			}
+			r.header = true
+		}
+		row, err := r.csv.Read()
+		if err != nil {
+			return record{}, err
+		}
+		return recordFromRow(row)
+	case Protobuf:
+		wire := &pb.Event{}
+		if err := protodelim.UnmarshalFrom(r.in, wire); err != nil {
+			return record{}, err
+		}
+		return recordFromWire(wire), nil
+	default:
+		return record{}, fmt.Errorf("unsupported format %s", r.format)
+	}
+}
+
+// ReadAll decodes every event in the stream.
+func ReadAll(in io.Reader, format Format) ([]simulator2.Event, error) {
+	r := NewReader(in, format)
+	events := make([]simulator2.Event, 0)
+	for {
+		event, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		}
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+}
+
+// ReadFile decodes every event in the file at path, picking the format with FormatFor.
+func ReadFile(path string) ([]simulator2.Event, error) {
+	format, err := FormatFor(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadAll(file, format)
+}
@@ -0,0 +1,93 @@
+package eventio
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Writer is a simulator2.ControllerListener streaming each event to an io.Writer as it is produced.  Listeners are
+// unable to report failures, so the first error encountered is retained, stopping further output, and reported by
+// Close.
+type Writer struct {
+	format Format
+	out    *bufio.Writer
+	json   *json.Encoder
+	csv    *csv.Writer
+	// closer is the underlying file when created by Create.
+	closer io.Closer
+	err    error
+}
+
+// NewWriter streams events to out in the given format.  Output is buffered until Close.
+func NewWriter(out io.Writer, format Format) *Writer {
+	w := &Writer{format: format, out: bufio.NewWriter(out)}
+	switch format {
+	case JSONLines:
+		w.json = json.NewEncoder(w.out)
+	case CSV:
+		w.csv = csv.NewWriter(w.out)
+		w.err = w.csv.Write(columns)
+	}
+	return w
+}
+
+// Create streams events to the file at path, picking the format with FormatFor.
+func Create(path string) (*Writer, error) {
+	format, err := FormatFor(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w := NewWriter(file, format)
+	w.closer = file
+	return w, nil
+}
+
+func (w *Writer) OnControllerEvent(event simulator2.Event) {
+	if w.err != nil {
+		return
+	}
+	r := toRecord(event)
+	switch w.format {
+	case JSONLines:
+		w.err = w.json.Encode(r)
+	case CSV:
+		w.err = w.csv.Write(r.row())
+	case Protobuf:
+		_, w.err = protodelim.MarshalTo(w.out, r.toWire())
+	}
+}
+
+// Err is the first error encountered while writing, if any.
+func (w *Writer) Err() error {
+	return w.err
+}
+
+// Close flushes any buffered events, closing the file if the Writer was created by Create.  Listeners attached to a
+// simulation must be detached before closing.
+func (w *Writer) Close() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if w.err == nil {
+			w.err = w.csv.Error()
+		}
+	}
+	if err := w.out.Flush(); w.err == nil {
+		w.err = err
+	}
+	if w.closer != nil {
+		if err := w.closer.Close(); w.err == nil {
+			w.err = err
+		}
+	}
+	return w.err
+}
@@ -2,10 +2,12 @@ package telepathy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	pb2 "github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
 	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
 	"google.golang.org/grpc"
+	"log/slog"
 	"time"
 )
 
@@ -25,11 +27,23 @@ func (c *ConnectionError) Error() string {
 type Landing struct {
 	connection *grpc.ClientConn
 	client     pb2.ControllerServiceClient
+	logger     *slog.Logger
 }
 
-func DialLanding(address string) (*Landing, error) {
+type LandingOption func(l *Landing)
+
+// WithLogger directs diagnostic output of the landing and its bridged controllers to the logger.  Output is discarded
+// by default.
+func WithLogger(logger *slog.Logger) LandingOption {
+	return func(l *Landing) {
+		l.logger = logger
+	}
+}
+
+func DialLanding(address string, options ...LandingOption) (*Landing, error) {
 	// Set up a connection to the server.
-	fmt.Printf("Attempting to connect to %q\n", address)
+	logger := landingLogger(options)
+	logger.Debug("connecting", "address", address)
 	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(1*time.Second))
 	if err != nil {
 		return nil, &ConnectionError{
@@ -37,16 +51,28 @@ func DialLanding(address string) (*Landing, error) {
 			Underlying: err,
 		}
 	}
-	return LandingWithConnection(conn), nil
+	return LandingWithConnection(conn, WithLogger(logger)), nil
 }
 
-func LandingWithConnection(conn *grpc.ClientConn) *Landing {
+func LandingWithConnection(conn *grpc.ClientConn, options ...LandingOption) *Landing {
 	c := pb2.NewControllerServiceClient(conn)
 
 	return &Landing{
 		connection: conn,
 		client:     c,
+		logger:     landingLogger(options),
+	}
+}
+
+func landingLogger(options []LandingOption) *slog.Logger {
+	l := &Landing{}
+	for _, o := range options {
+		o(l)
+	}
+	if l.logger == nil {
+		return slog.New(slog.DiscardHandler)
 	}
+	return l.logger
 }
 
 func (l *Landing) ControllerAdapter() simulator2.ControllerFunc {
@@ -56,12 +82,15 @@ func (l *Landing) ControllerAdapter() simulator2.ControllerFunc {
 
 		result, err := l.client.Spawn(ctx, &pb2.SpawnOptions{})
 		if err != nil {
-			panic(err)
+			l.logger.Debug("unable to spawn remote controller", "err", err)
+			reportFault(elevators, "Spawn", err)
+			return &unspawnedController{}
 		}
 		return &BridgedController{
 			controllerID: result.Id,
 			landing:      l,
 			controls:     elevators,
+			logger:       l.logger.With("controller", result.Id),
 		}
 	}
 }
@@ -71,28 +100,56 @@ type BridgedController struct {
 	controllerID uint32
 	controls     simulator2.ControlledElevators
 	elevators    []simulator2.ElevatorID
+	floorCount   uint32
+	served       simulator2.ServedFloors
+	logger       *slog.Logger
+}
+
+// InitBuilding retains the number of floors within the building to be forwarded with the initialization of the remote
+// controller.
+func (m *BridgedController) InitBuilding(building simulator2.Building) {
+	m.floorCount = uint32(len(building.Floors))
+}
+
+// InitZones retains the floors served by each elevator to be forwarded with the initialization of the remote controller.
+func (m *BridgedController) InitZones(served simulator2.ServedFloors) {
+	m.served = served
 }
 
 func (m *BridgedController) Init(elevators []simulator2.ElevatorID) {
 	m.elevators = elevators
+	served := make([]*pb2.SimulationEvent_Init_ServedFloors, 0, len(m.served))
+	for index, elevator := range elevators {
+		floors := m.served[elevator]
+		wire := &pb2.SimulationEvent_Init_ServedFloors{
+			Elevator: &pb2.Elevator{ElevatorIndex: uint32(index)},
+			Floors:   make([]*pb2.Floor, len(floors)),
+		}
+		for i, floor := range floors {
+			wire.Floors[i] = &pb2.Floor{FloorIndex: uint32(floor)}
+		}
+		served = append(served, wire)
+	}
 	m.dispatch(&pb2.SimulationEvent{
-		When: nil,
 		Initialize: &pb2.SimulationEvent_Init{
 			ElevatorCount: uint32(len(elevators)),
-			FloorCount:    5,
+			FloorCount:    m.floorCount,
+			Served:        served,
 		},
 	})
 }
 
-func (m *BridgedController) Called(floor simulator2.FloorID) {
+func (m *BridgedController) Called(floor simulator2.FloorID, direction simulator2.Direction) {
 	m.dispatch(&pb2.SimulationEvent{
-		Called: &pb2.SimulationEvent_ElevatorCalled{CalledAt: &pb2.Floor{FloorIndex: uint32(floor)}},
+		Called: &pb2.SimulationEvent_ElevatorCalled{
+			CalledAt:  &pb2.Floor{FloorIndex: uint32(floor)},
+			Direction: convertDirectionToWire(direction),
+		},
 	})
 }
 
 func (m *BridgedController) FloorSelected(elevatorID simulator2.ElevatorID, floor simulator2.FloorID) {
 	m.dispatch(&pb2.SimulationEvent{
-		When: &pb2.Tick{V0: 0},
 		FloorSelection: &pb2.SimulationEvent_FloorSelected{
 			InElevator: &pb2.Elevator{ElevatorIndex: uint32(elevatorID)},
 			Selected:   &pb2.Floor{FloorIndex: uint32(floor)},
@@ -108,44 +165,182 @@ func (m *BridgedController) CompletedMove(elevatorID simulator2.ElevatorID) {
 	})
 }
 
+// MoveDeferred forwards deferred moves to the remote controller, which may optionally observe them.
+func (m *BridgedController) MoveDeferred(elevatorID simulator2.ElevatorID, floor simulator2.FloorID) {
+	m.dispatch(&pb2.SimulationEvent{
+		Deferred: &pb2.SimulationEvent_MoveDeferred{
+			Which:  &pb2.Elevator{ElevatorIndex: uint32(elevatorID)},
+			Target: &pb2.Floor{FloorIndex: uint32(floor)},
+		},
+	})
+}
+
+// OutOfService forwards elevators being taken out of service to the remote controller, which may optionally observe
+// them.
+func (m *BridgedController) OutOfService(elevatorID simulator2.ElevatorID, fault simulator2.FaultKind) {
+	m.dispatch(&pb2.SimulationEvent{
+		Service: &pb2.SimulationEvent_ServiceChanged{
+			Which: &pb2.Elevator{ElevatorIndex: uint32(elevatorID)},
+			Fault: convertFaultToWire(fault),
+		},
+	})
+}
+
+// Restored forwards elevators returning to service to the remote controller.
+func (m *BridgedController) Restored(elevatorID simulator2.ElevatorID) {
+	m.dispatch(&pb2.SimulationEvent{
+		Service: &pb2.SimulationEvent_ServiceChanged{
+			Which: &pb2.Elevator{ElevatorIndex: uint32(elevatorID)},
+			Fault: pb2.ElevatorFault_ELEVATOR_FAULT_NONE,
+		},
+	})
+}
+
+// MoveRejected informs the remote controller a directive it issued could not be performed.
+func (m *BridgedController) MoveRejected(elevatorID simulator2.ElevatorID, floor simulator2.FloorID, err error) {
+	fault := pb2.ElevatorFault_ELEVATOR_FAULT_NONE
+	var outOfService *simulator2.OutOfServiceError
+	if errors.As(err, &outOfService) {
+		fault = convertFaultToWire(outOfService.Fault)
+	}
+	m.dispatch(&pb2.SimulationEvent{
+		Rejected: &pb2.SimulationEvent_MoveRejected{
+			Which:  &pb2.Elevator{ElevatorIndex: uint32(elevatorID)},
+			Target: &pb2.Floor{FloorIndex: uint32(floor)},
+			Fault:  fault,
+		},
+	})
+}
+
+// ControllerFault informs the remote controller a directive it issued referenced an elevator or floor which does not
+// exist.
+func (m *BridgedController) ControllerFault(err error) {
+	var fault *simulator2.ControllerFaultError
+	if !errors.As(err, &fault) {
+		return
+	}
+	wire := &pb2.SimulationEvent_ControllerFault{
+		Command: fault.Command,
+		Which:   &pb2.Elevator{ElevatorIndex: uint32(fault.Elevator)},
+		Reason:  fault.Reason,
+	}
+	if fault.Floor != simulator2.NoFloor {
+		wire.Target = &pb2.Floor{FloorIndex: uint32(fault.Floor)}
+	}
+	m.dispatch(&pb2.SimulationEvent{Fault: wire})
+}
+
+// Tick gives the remote controller control at the start of each tick.
+func (m *BridgedController) Tick(tick simulator2.Tick) {
+	m.dispatch(&pb2.SimulationEvent{
+		Ticked: &pb2.SimulationEvent_TickStarted{},
+	})
+}
+
 func (m *BridgedController) dispatch(e *pb2.SimulationEvent) {
+	e.When = &pb2.Tick{V0: uint64(m.controls.Now())}
 	ctx, done := context.WithTimeout(context.Background(), time.Second*1)
 	defer done()
 
 	updates, err := m.landing.client.Notice(ctx, &pb2.SimulationNotice{
-		Target: &pb2.Controller{Id: m.controllerID},
-		Event:  []*pb2.SimulationEvent{e},
+		Target:    &pb2.Controller{Id: m.controllerID},
+		Event:     []*pb2.SimulationEvent{e},
+		Elevators: convertStatusesToWire(m.controls.Statuses()),
 	})
 	if err != nil {
-		panic(err)
+		m.logger.Debug("remote controller failed to notice event", "err", err)
+		reportFault(m.controls, "Notice", err)
+		return
 	}
 
 	for _, p := range updates.Pending {
 		if p.SeekFloor != nil {
 			floor := convertFloorFromWire(p.SeekFloor.Target)
-			elevator, err := m.convertElevatorFromWire(p.SeekFloor.Which)
-			if err != nil {
-				//TODO: Should end the simulation
-				panic(err)
-			}
-			fmt.Printf("Sending to floor %d for elevator %d\n", floor, elevator)
+			elevator := m.convertElevatorFromWire(p.SeekFloor.Which)
+			m.logger.Debug("moving elevator", "elevator", elevator, "floor", floor)
 
 			m.controls.MoveTo(elevator, floor)
 		}
+		if p.Enqueue != nil {
+			elevator := m.convertElevatorFromWire(p.Enqueue.Which)
+			stops := make([]simulator2.FloorID, len(p.Enqueue.Stops))
+			for i, stop := range p.Enqueue.Stops {
+				stops[i] = convertFloorFromWire(stop)
+			}
+			m.logger.Debug("enqueuing stops", "elevator", elevator, "stops", stops)
+			m.controls.EnqueueStops(elevator, stops...)
+		}
+		if p.Insert != nil {
+			elevator := m.convertElevatorFromWire(p.Insert.Which)
+			floor := convertFloorFromWire(p.Insert.Stop)
+			m.logger.Debug("inserting stop", "elevator", elevator, "floor", floor, "position", p.Insert.Position)
+			m.controls.InsertStop(elevator, int(p.Insert.Position), floor)
+		}
+		if p.Clear != nil {
+			elevator := m.convertElevatorFromWire(p.Clear.Which)
+			m.logger.Debug("clearing stops", "elevator", elevator)
+			m.controls.ClearStops(elevator)
+		}
+	}
+}
+
+// reportFault holds the failure of the remote controller against it as a controller fault, when the simulation accepts
+// such reports.
+func reportFault(elevators simulator2.ControlledElevators, command string, err error) {
+	reporter, ok := elevators.(simulator2.ControllerFaultReporter)
+	if !ok {
+		return
 	}
+	reporter.ReportControllerFault(&simulator2.ControllerFaultError{
+		Command:  command,
+		Elevator: simulator2.NoElevator,
+		Floor:    simulator2.NoFloor,
+		Reason:   err.Error(),
+	})
 }
 
+// unspawnedController stands in for a remote controller which could not be spawned, leaving the elevators idle.
+type unspawnedController struct{}
+
+func (u *unspawnedController) Init([]simulator2.ElevatorID)                            {}
+func (u *unspawnedController) Called(simulator2.FloorID, simulator2.Direction)         {}
+func (u *unspawnedController) FloorSelected(simulator2.ElevatorID, simulator2.FloorID) {}
+func (u *unspawnedController) CompletedMove(simulator2.ElevatorID)                     {}
+
 func convertFloorFromWire(input *pb2.Floor) simulator2.FloorID {
-	index := input.FloorIndex
-	fmt.Printf("Floor %d\n", index)
-	return simulator2.FloorID(index)
+	return simulator2.FloorID(input.GetFloorIndex())
+}
+
+// convertElevatorFromWire maps the index onto the elevators given at initialization.  Unknown indexes are passed through
+// for the simulation to report as a controller fault.
+func (m *BridgedController) convertElevatorFromWire(input *pb2.Elevator) simulator2.ElevatorID {
+	index := int(input.GetElevatorIndex())
+	if index >= len(m.elevators) {
+		return simulator2.ElevatorID(index)
+	}
+	return m.elevators[index]
+}
+
+func convertDirectionToWire(direction simulator2.Direction) pb2.CallDirection {
+	switch direction {
+	case simulator2.DirectionUp:
+		return pb2.CallDirection_CALL_DIRECTION_UP
+	case simulator2.DirectionDown:
+		return pb2.CallDirection_CALL_DIRECTION_DOWN
+	default:
+		return pb2.CallDirection_CALL_DIRECTION_UNSPECIFIED
+	}
 }
 
-func (m *BridgedController) convertElevatorFromWire(input *pb2.Elevator) (simulator2.ElevatorID, error) {
-	index := input.ElevatorIndex
-	if len(m.elevators) < int(index) {
-		return -1, fmt.Errorf("got elevator index %d, max %d", index, len(m.elevators))
+func convertFaultToWire(fault simulator2.FaultKind) pb2.ElevatorFault {
+	switch fault {
+	case simulator2.FaultBreakdown:
+		return pb2.ElevatorFault_ELEVATOR_FAULT_BREAKDOWN
+	case simulator2.FaultDoors:
+		return pb2.ElevatorFault_ELEVATOR_FAULT_DOORS
+	case simulator2.FaultMaintenance:
+		return pb2.ElevatorFault_ELEVATOR_FAULT_MAINTENANCE
+	default:
+		return pb2.ElevatorFault_ELEVATOR_FAULT_NONE
 	}
-	fmt.Printf("Elevator @ index %d\n", index)
-	return m.elevators[index], nil
 }
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.10
 // 	protoc        v3.17.3
 // source: pkg/ipc/grpc/telepathy/pb/telepathy.proto
 
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -20,21 +21,177 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CallDirection int32
+
+const (
+	CallDirection_CALL_DIRECTION_UNSPECIFIED CallDirection = 0
+	CallDirection_CALL_DIRECTION_UP          CallDirection = 1
+	CallDirection_CALL_DIRECTION_DOWN        CallDirection = 2
+)
+
+// Enum value maps for CallDirection.
+var (
+	CallDirection_name = map[int32]string{
+		0: "CALL_DIRECTION_UNSPECIFIED",
+		1: "CALL_DIRECTION_UP",
+		2: "CALL_DIRECTION_DOWN",
+	}
+	CallDirection_value = map[string]int32{
+		"CALL_DIRECTION_UNSPECIFIED": 0,
+		"CALL_DIRECTION_UP":          1,
+		"CALL_DIRECTION_DOWN":        2,
+	}
+)
+
+func (x CallDirection) Enum() *CallDirection {
+	p := new(CallDirection)
+	*p = x
+	return p
+}
+
+func (x CallDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes[0].Descriptor()
+}
+
+func (CallDirection) Type() protoreflect.EnumType {
+	return &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes[0]
+}
+
+func (x CallDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallDirection.Descriptor instead.
+func (CallDirection) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{0}
+}
+
+type ElevatorFault int32
+
+const (
+	ElevatorFault_ELEVATOR_FAULT_NONE        ElevatorFault = 0
+	ElevatorFault_ELEVATOR_FAULT_BREAKDOWN   ElevatorFault = 1
+	ElevatorFault_ELEVATOR_FAULT_DOORS       ElevatorFault = 2
+	ElevatorFault_ELEVATOR_FAULT_MAINTENANCE ElevatorFault = 3
+)
+
+// Enum value maps for ElevatorFault.
+var (
+	ElevatorFault_name = map[int32]string{
+		0: "ELEVATOR_FAULT_NONE",
+		1: "ELEVATOR_FAULT_BREAKDOWN",
+		2: "ELEVATOR_FAULT_DOORS",
+		3: "ELEVATOR_FAULT_MAINTENANCE",
+	}
+	ElevatorFault_value = map[string]int32{
+		"ELEVATOR_FAULT_NONE":        0,
+		"ELEVATOR_FAULT_BREAKDOWN":   1,
+		"ELEVATOR_FAULT_DOORS":       2,
+		"ELEVATOR_FAULT_MAINTENANCE": 3,
+	}
+)
+
+func (x ElevatorFault) Enum() *ElevatorFault {
+	p := new(ElevatorFault)
+	*p = x
+	return p
+}
+
+func (x ElevatorFault) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElevatorFault) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes[1].Descriptor()
+}
+
+func (ElevatorFault) Type() protoreflect.EnumType {
+	return &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes[1]
+}
+
+func (x ElevatorFault) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElevatorFault.Descriptor instead.
+func (ElevatorFault) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{1}
+}
+
+type ElevatorState int32
+
+const (
+	ElevatorState_ELEVATOR_STATE_IDLE          ElevatorState = 0
+	ElevatorState_ELEVATOR_STATE_MOVING_UP     ElevatorState = 1
+	ElevatorState_ELEVATOR_STATE_MOVING_DOWN   ElevatorState = 2
+	ElevatorState_ELEVATOR_STATE_DOORS_OPENING ElevatorState = 3
+	ElevatorState_ELEVATOR_STATE_DOORS_OPEN    ElevatorState = 4
+	ElevatorState_ELEVATOR_STATE_DOORS_CLOSING ElevatorState = 5
+)
+
+// Enum value maps for ElevatorState.
+var (
+	ElevatorState_name = map[int32]string{
+		0: "ELEVATOR_STATE_IDLE",
+		1: "ELEVATOR_STATE_MOVING_UP",
+		2: "ELEVATOR_STATE_MOVING_DOWN",
+		3: "ELEVATOR_STATE_DOORS_OPENING",
+		4: "ELEVATOR_STATE_DOORS_OPEN",
+		5: "ELEVATOR_STATE_DOORS_CLOSING",
+	}
+	ElevatorState_value = map[string]int32{
+		"ELEVATOR_STATE_IDLE":          0,
+		"ELEVATOR_STATE_MOVING_UP":     1,
+		"ELEVATOR_STATE_MOVING_DOWN":   2,
+		"ELEVATOR_STATE_DOORS_OPENING": 3,
+		"ELEVATOR_STATE_DOORS_OPEN":    4,
+		"ELEVATOR_STATE_DOORS_CLOSING": 5,
+	}
+)
+
+func (x ElevatorState) Enum() *ElevatorState {
+	p := new(ElevatorState)
+	*p = x
+	return p
+}
+
+func (x ElevatorState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElevatorState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes[2].Descriptor()
+}
+
+func (ElevatorState) Type() protoreflect.EnumType {
+	return &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes[2]
+}
+
+func (x ElevatorState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElevatorState.Descriptor instead.
+func (ElevatorState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{2}
+}
+
 type Controller struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Controller) Reset() {
 	*x = Controller{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Controller) String() string {
@@ -45,7 +202,7 @@ func (*Controller) ProtoMessage() {}
 
 func (x *Controller) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -68,20 +225,17 @@ func (x *Controller) GetId() uint32 {
 }
 
 type Tick struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	V0            uint64                 `protobuf:"varint,1,opt,name=v0,proto3" json:"v0,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	V0 uint64 `protobuf:"varint,1,opt,name=v0,proto3" json:"v0,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Tick) Reset() {
 	*x = Tick{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Tick) String() string {
@@ -92,7 +246,7 @@ func (*Tick) ProtoMessage() {}
 
 func (x *Tick) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -115,20 +269,17 @@ func (x *Tick) GetV0() uint64 {
 }
 
 type Elevator struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ElevatorIndex uint32                 `protobuf:"varint,1,opt,name=elevatorIndex,proto3" json:"elevatorIndex,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ElevatorIndex uint32 `protobuf:"varint,1,opt,name=elevatorIndex,proto3" json:"elevatorIndex,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Elevator) Reset() {
 	*x = Elevator{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Elevator) String() string {
@@ -139,7 +290,7 @@ func (*Elevator) ProtoMessage() {}
 
 func (x *Elevator) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -162,20 +313,17 @@ func (x *Elevator) GetElevatorIndex() uint32 {
 }
 
 type Floor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloorIndex    uint32                 `protobuf:"varint,1,opt,name=floorIndex,proto3" json:"floorIndex,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	FloorIndex uint32 `protobuf:"varint,1,opt,name=floorIndex,proto3" json:"floorIndex,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Floor) Reset() {
 	*x = Floor{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Floor) String() string {
@@ -186,7 +334,7 @@ func (*Floor) ProtoMessage() {}
 
 func (x *Floor) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -208,22 +356,152 @@ func (x *Floor) GetFloorIndex() uint32 {
 	return 0
 }
 
-type SimulationNotice struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+type ElevatorStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Floor         *Floor                 `protobuf:"bytes,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction     CallDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=CallDirection" json:"direction,omitempty"`
+	State         ElevatorState          `protobuf:"varint,4,opt,name=state,proto3,enum=ElevatorState" json:"state,omitempty"`
+	Target        *Floor                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Load          uint32                 `protobuf:"varint,6,opt,name=load,proto3" json:"load,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CarCalls      []*Floor               `protobuf:"bytes,8,rep,name=carCalls,proto3" json:"carCalls,omitempty"`
+	Fault         ElevatorFault          `protobuf:"varint,9,opt,name=fault,proto3,enum=ElevatorFault" json:"fault,omitempty"`
+	Itinerary     []*Floor               `protobuf:"bytes,10,rep,name=itinerary,proto3" json:"itinerary,omitempty"`
+	Riders        uint32                 `protobuf:"varint,11,opt,name=riders,proto3" json:"riders,omitempty"`
+	RatedLoad     uint32                 `protobuf:"varint,12,opt,name=ratedLoad,proto3" json:"ratedLoad,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Target *Controller        `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Event  []*SimulationEvent `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
+func (x *ElevatorStatus) Reset() {
+	*x = ElevatorStatus{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulationNotice) Reset() {
-	*x = SimulationNotice{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[4]
+func (x *ElevatorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElevatorStatus) ProtoMessage() {}
+
+func (x *ElevatorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[4]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElevatorStatus.ProtoReflect.Descriptor instead.
+func (*ElevatorStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ElevatorStatus) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *ElevatorStatus) GetFloor() *Floor {
+	if x != nil {
+		return x.Floor
+	}
+	return nil
+}
+
+func (x *ElevatorStatus) GetDirection() CallDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return CallDirection_CALL_DIRECTION_UNSPECIFIED
+}
+
+func (x *ElevatorStatus) GetState() ElevatorState {
+	if x != nil {
+		return x.State
+	}
+	return ElevatorState_ELEVATOR_STATE_IDLE
+}
+
+func (x *ElevatorStatus) GetTarget() *Floor {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ElevatorStatus) GetLoad() uint32 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *ElevatorStatus) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ElevatorStatus) GetCarCalls() []*Floor {
+	if x != nil {
+		return x.CarCalls
+	}
+	return nil
+}
+
+func (x *ElevatorStatus) GetFault() ElevatorFault {
+	if x != nil {
+		return x.Fault
+	}
+	return ElevatorFault_ELEVATOR_FAULT_NONE
+}
+
+func (x *ElevatorStatus) GetItinerary() []*Floor {
+	if x != nil {
+		return x.Itinerary
+	}
+	return nil
+}
+
+func (x *ElevatorStatus) GetRiders() uint32 {
+	if x != nil {
+		return x.Riders
 	}
+	return 0
+}
+
+func (x *ElevatorStatus) GetRatedLoad() uint32 {
+	if x != nil {
+		return x.RatedLoad
+	}
+	return 0
+}
+
+type SimulationNotice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *Controller            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Event         []*SimulationEvent     `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
+	Elevators     []*ElevatorStatus      `protobuf:"bytes,3,rep,name=elevators,proto3" json:"elevators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationNotice) Reset() {
+	*x = SimulationNotice{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SimulationNotice) String() string {
@@ -233,8 +511,8 @@ func (x *SimulationNotice) String() string {
 func (*SimulationNotice) ProtoMessage() {}
 
 func (x *SimulationNotice) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[5]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -246,7 +524,7 @@ func (x *SimulationNotice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationNotice.ProtoReflect.Descriptor instead.
 func (*SimulationNotice) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{4}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SimulationNotice) GetTarget() *Controller {
@@ -263,25 +541,34 @@ func (x *SimulationNotice) GetEvent() []*SimulationEvent {
 	return nil
 }
 
-type SimulationEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SimulationNotice) GetElevators() []*ElevatorStatus {
+	if x != nil {
+		return x.Elevators
+	}
+	return nil
+}
 
+type SimulationEvent struct {
+	state          protoimpl.MessageState           `protogen:"open.v1"`
 	When           *Tick                            `protobuf:"bytes,1,opt,name=when,proto3" json:"when,omitempty"`
 	Called         *SimulationEvent_ElevatorCalled  `protobuf:"bytes,2,opt,name=called,proto3" json:"called,omitempty"`
 	Arriving       *SimulationEvent_ElevatorArrived `protobuf:"bytes,3,opt,name=arriving,proto3" json:"arriving,omitempty"`
 	FloorSelection *SimulationEvent_FloorSelected   `protobuf:"bytes,4,opt,name=floorSelection,proto3" json:"floorSelection,omitempty"`
 	Initialize     *SimulationEvent_Init            `protobuf:"bytes,5,opt,name=initialize,proto3" json:"initialize,omitempty"`
+	Deferred       *SimulationEvent_MoveDeferred    `protobuf:"bytes,6,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	Service        *SimulationEvent_ServiceChanged  `protobuf:"bytes,7,opt,name=service,proto3" json:"service,omitempty"`
+	Rejected       *SimulationEvent_MoveRejected    `protobuf:"bytes,8,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Ticked         *SimulationEvent_TickStarted     `protobuf:"bytes,9,opt,name=ticked,proto3" json:"ticked,omitempty"`
+	Fault          *SimulationEvent_ControllerFault `protobuf:"bytes,10,opt,name=fault,proto3" json:"fault,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SimulationEvent) Reset() {
 	*x = SimulationEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SimulationEvent) String() string {
@@ -291,8 +578,8 @@ func (x *SimulationEvent) String() string {
 func (*SimulationEvent) ProtoMessage() {}
 
 func (x *SimulationEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[6]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -304,7 +591,7 @@ func (x *SimulationEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationEvent.ProtoReflect.Descriptor instead.
 func (*SimulationEvent) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{5}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SimulationEvent) GetWhen() *Tick {
@@ -342,21 +629,53 @@ func (x *SimulationEvent) GetInitialize() *SimulationEvent_Init {
 	return nil
 }
 
+func (x *SimulationEvent) GetDeferred() *SimulationEvent_MoveDeferred {
+	if x != nil {
+		return x.Deferred
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetService() *SimulationEvent_ServiceChanged {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetRejected() *SimulationEvent_MoveRejected {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetTicked() *SimulationEvent_TickStarted {
+	if x != nil {
+		return x.Ticked
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetFault() *SimulationEvent_ControllerFault {
+	if x != nil {
+		return x.Fault
+	}
+	return nil
+}
+
 type ControllerUpdates struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pending       []*ControllerDirective `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Pending []*ControllerDirective `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ControllerUpdates) Reset() {
 	*x = ControllerUpdates{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ControllerUpdates) String() string {
@@ -366,8 +685,8 @@ func (x *ControllerUpdates) String() string {
 func (*ControllerUpdates) ProtoMessage() {}
 
 func (x *ControllerUpdates) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[7]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -379,7 +698,7 @@ func (x *ControllerUpdates) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerUpdates.ProtoReflect.Descriptor instead.
 func (*ControllerUpdates) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ControllerUpdates) GetPending() []*ControllerDirective {
@@ -390,21 +709,21 @@ func (x *ControllerUpdates) GetPending() []*ControllerDirective {
 }
 
 type ControllerDirective struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	When          *Tick                             `protobuf:"bytes,1,opt,name=when,proto3" json:"when,omitempty"`
+	SeekFloor     *ControllerDirective_MoveTo       `protobuf:"bytes,2,opt,name=seekFloor,proto3" json:"seekFloor,omitempty"`
+	Enqueue       *ControllerDirective_EnqueueStops `protobuf:"bytes,3,opt,name=enqueue,proto3" json:"enqueue,omitempty"`
+	Insert        *ControllerDirective_InsertStop   `protobuf:"bytes,4,opt,name=insert,proto3" json:"insert,omitempty"`
+	Clear         *ControllerDirective_ClearStops   `protobuf:"bytes,5,opt,name=clear,proto3" json:"clear,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	When      *Tick                       `protobuf:"bytes,1,opt,name=when,proto3" json:"when,omitempty"`
-	SeekFloor *ControllerDirective_MoveTo `protobuf:"bytes,2,opt,name=seekFloor,proto3" json:"seekFloor,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ControllerDirective) Reset() {
 	*x = ControllerDirective{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ControllerDirective) String() string {
@@ -414,8 +733,8 @@ func (x *ControllerDirective) String() string {
 func (*ControllerDirective) ProtoMessage() {}
 
 func (x *ControllerDirective) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[8]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -427,7 +746,7 @@ func (x *ControllerDirective) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerDirective.ProtoReflect.Descriptor instead.
 func (*ControllerDirective) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{7}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ControllerDirective) GetWhen() *Tick {
@@ -444,19 +763,38 @@ func (x *ControllerDirective) GetSeekFloor() *ControllerDirective_MoveTo {
 	return nil
 }
 
-type SpawnOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+func (x *ControllerDirective) GetEnqueue() *ControllerDirective_EnqueueStops {
+	if x != nil {
+		return x.Enqueue
+	}
+	return nil
+}
+
+func (x *ControllerDirective) GetInsert() *ControllerDirective_InsertStop {
+	if x != nil {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *ControllerDirective) GetClear() *ControllerDirective_ClearStops {
+	if x != nil {
+		return x.Clear
+	}
+	return nil
+}
+
+type SpawnOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
 func (x *SpawnOptions) Reset() {
 	*x = SpawnOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SpawnOptions) String() string {
@@ -466,8 +804,8 @@ func (x *SpawnOptions) String() string {
 func (*SpawnOptions) ProtoMessage() {}
 
 func (x *SpawnOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[9]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -479,24 +817,22 @@ func (x *SpawnOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawnOptions.ProtoReflect.Descriptor instead.
 func (*SpawnOptions) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{8}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{9}
 }
 
 type SimulationEvent_ElevatorCalled struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CalledAt      *Floor                 `protobuf:"bytes,1,opt,name=calledAt,proto3" json:"calledAt,omitempty"`
+	Direction     CallDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=CallDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CalledAt *Floor `protobuf:"bytes,1,opt,name=calledAt,proto3" json:"calledAt,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SimulationEvent_ElevatorCalled) Reset() {
 	*x = SimulationEvent_ElevatorCalled{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SimulationEvent_ElevatorCalled) String() string {
@@ -506,8 +842,8 @@ func (x *SimulationEvent_ElevatorCalled) String() string {
 func (*SimulationEvent_ElevatorCalled) ProtoMessage() {}
 
 func (x *SimulationEvent_ElevatorCalled) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[10]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -519,7 +855,7 @@ func (x *SimulationEvent_ElevatorCalled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationEvent_ElevatorCalled.ProtoReflect.Descriptor instead.
 func (*SimulationEvent_ElevatorCalled) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{5, 0}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *SimulationEvent_ElevatorCalled) GetCalledAt() *Floor {
@@ -529,22 +865,26 @@ func (x *SimulationEvent_ElevatorCalled) GetCalledAt() *Floor {
 	return nil
 }
 
+func (x *SimulationEvent_ElevatorCalled) GetDirection() CallDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return CallDirection_CALL_DIRECTION_UNSPECIFIED
+}
+
 type SimulationEvent_ElevatorArrived struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Arriving      *Elevator              `protobuf:"bytes,1,opt,name=arriving,proto3" json:"arriving,omitempty"`
+	AtLocation    *Floor                 `protobuf:"bytes,2,opt,name=atLocation,proto3" json:"atLocation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Arriving   *Elevator `protobuf:"bytes,1,opt,name=arriving,proto3" json:"arriving,omitempty"`
-	AtLocation *Floor    `protobuf:"bytes,2,opt,name=atLocation,proto3" json:"atLocation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SimulationEvent_ElevatorArrived) Reset() {
 	*x = SimulationEvent_ElevatorArrived{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SimulationEvent_ElevatorArrived) String() string {
@@ -554,8 +894,8 @@ func (x *SimulationEvent_ElevatorArrived) String() string {
 func (*SimulationEvent_ElevatorArrived) ProtoMessage() {}
 
 func (x *SimulationEvent_ElevatorArrived) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[11]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -567,7 +907,7 @@ func (x *SimulationEvent_ElevatorArrived) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationEvent_ElevatorArrived.ProtoReflect.Descriptor instead.
 func (*SimulationEvent_ElevatorArrived) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{5, 1}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *SimulationEvent_ElevatorArrived) GetArriving() *Elevator {
@@ -585,21 +925,18 @@ func (x *SimulationEvent_ElevatorArrived) GetAtLocation() *Floor {
 }
 
 type SimulationEvent_FloorSelected struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InElevator    *Elevator              `protobuf:"bytes,1,opt,name=inElevator,proto3" json:"inElevator,omitempty"`
+	Selected      *Floor                 `protobuf:"bytes,2,opt,name=selected,proto3" json:"selected,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	InElevator *Elevator `protobuf:"bytes,1,opt,name=inElevator,proto3" json:"inElevator,omitempty"`
-	Selected   *Floor    `protobuf:"bytes,2,opt,name=selected,proto3" json:"selected,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SimulationEvent_FloorSelected) Reset() {
 	*x = SimulationEvent_FloorSelected{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SimulationEvent_FloorSelected) String() string {
@@ -609,8 +946,8 @@ func (x *SimulationEvent_FloorSelected) String() string {
 func (*SimulationEvent_FloorSelected) ProtoMessage() {}
 
 func (x *SimulationEvent_FloorSelected) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[12]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -622,7 +959,7 @@ func (x *SimulationEvent_FloorSelected) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationEvent_FloorSelected.ProtoReflect.Descriptor instead.
 func (*SimulationEvent_FloorSelected) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{5, 2}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *SimulationEvent_FloorSelected) GetInElevator() *Elevator {
@@ -640,21 +977,19 @@ func (x *SimulationEvent_FloorSelected) GetSelected() *Floor {
 }
 
 type SimulationEvent_Init struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	ElevatorCount uint32                               `protobuf:"varint,1,opt,name=ElevatorCount,proto3" json:"ElevatorCount,omitempty"`
+	FloorCount    uint32                               `protobuf:"varint,2,opt,name=FloorCount,proto3" json:"FloorCount,omitempty"`
+	Served        []*SimulationEvent_Init_ServedFloors `protobuf:"bytes,3,rep,name=served,proto3" json:"served,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ElevatorCount uint32 `protobuf:"varint,1,opt,name=ElevatorCount,proto3" json:"ElevatorCount,omitempty"`
-	FloorCount    uint32 `protobuf:"varint,2,opt,name=FloorCount,proto3" json:"FloorCount,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SimulationEvent_Init) Reset() {
 	*x = SimulationEvent_Init{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SimulationEvent_Init) String() string {
@@ -664,8 +999,8 @@ func (x *SimulationEvent_Init) String() string {
 func (*SimulationEvent_Init) ProtoMessage() {}
 
 func (x *SimulationEvent_Init) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[13]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -677,7 +1012,7 @@ func (x *SimulationEvent_Init) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationEvent_Init.ProtoReflect.Descriptor instead.
 func (*SimulationEvent_Init) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{5, 3}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 3}
 }
 
 func (x *SimulationEvent_Init) GetElevatorCount() uint32 {
@@ -694,22 +1029,346 @@ func (x *SimulationEvent_Init) GetFloorCount() uint32 {
 	return 0
 }
 
-type ControllerDirective_MoveTo struct {
-	state         protoimpl.MessageState
+func (x *SimulationEvent_Init) GetServed() []*SimulationEvent_Init_ServedFloors {
+	if x != nil {
+		return x.Served
+	}
+	return nil
+}
+
+type SimulationEvent_MoveDeferred struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Target        *Floor                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent_MoveDeferred) Reset() {
+	*x = SimulationEvent_MoveDeferred{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent_MoveDeferred) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent_MoveDeferred) ProtoMessage() {}
+
+func (x *SimulationEvent_MoveDeferred) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent_MoveDeferred.ProtoReflect.Descriptor instead.
+func (*SimulationEvent_MoveDeferred) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 4}
+}
+
+func (x *SimulationEvent_MoveDeferred) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *SimulationEvent_MoveDeferred) GetTarget() *Floor {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type SimulationEvent_ServiceChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Fault         ElevatorFault          `protobuf:"varint,2,opt,name=fault,proto3,enum=ElevatorFault" json:"fault,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent_ServiceChanged) Reset() {
+	*x = SimulationEvent_ServiceChanged{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent_ServiceChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent_ServiceChanged) ProtoMessage() {}
+
+func (x *SimulationEvent_ServiceChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent_ServiceChanged.ProtoReflect.Descriptor instead.
+func (*SimulationEvent_ServiceChanged) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 5}
+}
+
+func (x *SimulationEvent_ServiceChanged) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *SimulationEvent_ServiceChanged) GetFault() ElevatorFault {
+	if x != nil {
+		return x.Fault
+	}
+	return ElevatorFault_ELEVATOR_FAULT_NONE
+}
+
+type SimulationEvent_MoveRejected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Target        *Floor                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Fault         ElevatorFault          `protobuf:"varint,3,opt,name=fault,proto3,enum=ElevatorFault" json:"fault,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Which  *Elevator `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
-	Target *Floor    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+func (x *SimulationEvent_MoveRejected) Reset() {
+	*x = SimulationEvent_MoveRejected{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ControllerDirective_MoveTo) Reset() {
-	*x = ControllerDirective_MoveTo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[13]
+func (x *SimulationEvent_MoveRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent_MoveRejected) ProtoMessage() {}
+
+func (x *SimulationEvent_MoveRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent_MoveRejected.ProtoReflect.Descriptor instead.
+func (*SimulationEvent_MoveRejected) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 6}
+}
+
+func (x *SimulationEvent_MoveRejected) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *SimulationEvent_MoveRejected) GetTarget() *Floor {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SimulationEvent_MoveRejected) GetFault() ElevatorFault {
+	if x != nil {
+		return x.Fault
+	}
+	return ElevatorFault_ELEVATOR_FAULT_NONE
+}
+
+type SimulationEvent_TickStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent_TickStarted) Reset() {
+	*x = SimulationEvent_TickStarted{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent_TickStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent_TickStarted) ProtoMessage() {}
+
+func (x *SimulationEvent_TickStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent_TickStarted.ProtoReflect.Descriptor instead.
+func (*SimulationEvent_TickStarted) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 7}
+}
+
+type SimulationEvent_ControllerFault struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Which         *Elevator              `protobuf:"bytes,2,opt,name=which,proto3" json:"which,omitempty"`
+	Target        *Floor                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent_ControllerFault) Reset() {
+	*x = SimulationEvent_ControllerFault{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent_ControllerFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent_ControllerFault) ProtoMessage() {}
+
+func (x *SimulationEvent_ControllerFault) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent_ControllerFault.ProtoReflect.Descriptor instead.
+func (*SimulationEvent_ControllerFault) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 8}
+}
+
+func (x *SimulationEvent_ControllerFault) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SimulationEvent_ControllerFault) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *SimulationEvent_ControllerFault) GetTarget() *Floor {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SimulationEvent_ControllerFault) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SimulationEvent_Init_ServedFloors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elevator      *Elevator              `protobuf:"bytes,1,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Floors        []*Floor               `protobuf:"bytes,2,rep,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent_Init_ServedFloors) Reset() {
+	*x = SimulationEvent_Init_ServedFloors{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent_Init_ServedFloors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent_Init_ServedFloors) ProtoMessage() {}
+
+func (x *SimulationEvent_Init_ServedFloors) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent_Init_ServedFloors.ProtoReflect.Descriptor instead.
+func (*SimulationEvent_Init_ServedFloors) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{6, 3, 0}
+}
+
+func (x *SimulationEvent_Init_ServedFloors) GetElevator() *Elevator {
+	if x != nil {
+		return x.Elevator
+	}
+	return nil
+}
+
+func (x *SimulationEvent_Init_ServedFloors) GetFloors() []*Floor {
+	if x != nil {
+		return x.Floors
+	}
+	return nil
+}
+
+type ControllerDirective_MoveTo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Target        *Floor                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControllerDirective_MoveTo) Reset() {
+	*x = ControllerDirective_MoveTo{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ControllerDirective_MoveTo) String() string {
@@ -719,8 +1378,8 @@ func (x *ControllerDirective_MoveTo) String() string {
 func (*ControllerDirective_MoveTo) ProtoMessage() {}
 
 func (x *ControllerDirective_MoveTo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[20]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -732,7 +1391,7 @@ func (x *ControllerDirective_MoveTo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerDirective_MoveTo.ProtoReflect.Descriptor instead.
 func (*ControllerDirective_MoveTo) Descriptor() ([]byte, []int) {
-	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{7, 0}
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *ControllerDirective_MoveTo) GetWhich() *Elevator {
@@ -749,150 +1408,396 @@ func (x *ControllerDirective_MoveTo) GetTarget() *Floor {
 	return nil
 }
 
-var File_pkg_ipc_grpc_telepathy_pb_telepathy_proto protoreflect.FileDescriptor
+type ControllerDirective_EnqueueStops struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Stops         []*Floor               `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControllerDirective_EnqueueStops) Reset() {
+	*x = ControllerDirective_EnqueueStops{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc = []byte{
-	0x0a, 0x29, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74,
-	0x65, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x79, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x65, 0x6c, 0x65,
-	0x70, 0x61, 0x74, 0x68, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x0a, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x04, 0x54, 0x69, 0x63,
-	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x76,
-	0x30, 0x22, 0x30, 0x0a, 0x08, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a,
-	0x0d, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x27, 0x0a, 0x05, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5f, 0x0a, 0x10,
-	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
-	0x12, 0x23, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xe8, 0x04,
-	0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x06,
-	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x53,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45,
-	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x06, 0x63,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76, 0x69, 0x6e,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x72, 0x72, 0x69, 0x76, 0x65, 0x64, 0x52, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76,
-	0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x0e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x53, 0x69,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x6c,
-	0x6f, 0x6f, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x0e, 0x66, 0x6c, 0x6f,
-	0x6f, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x1a, 0x34, 0x0a, 0x0e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61,
-	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x08,
-	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x60, 0x0a, 0x0f, 0x45, 0x6c, 0x65, 0x76,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x72, 0x69, 0x76, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x61,
-	0x72, 0x72, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76, 0x69,
-	0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0a, 0x61, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x0a,
-	0x61, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x5e, 0x0a, 0x0d, 0x46, 0x6c,
-	0x6f, 0x6f, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0a, 0x69,
-	0x6e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x45, 0x6c,
-	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
-	0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x1a, 0x4c, 0x0a, 0x04, 0x49, 0x6e,
-	0x69, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x45, 0x6c, 0x65, 0x76, 0x61,
-	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x6f,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x46, 0x6c,
-	0x6f, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a,
-	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xb6, 0x01,
-	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e,
-	0x12, 0x39, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x6b, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f,
-	0x52, 0x09, 0x73, 0x65, 0x65, 0x6b, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x06, 0x4d,
-	0x6f, 0x76, 0x65, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x05, 0x77, 0x68, 0x69, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x05, 0x77, 0x68, 0x69, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x6d, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x53,
-	0x70, 0x61, 0x77, 0x6e, 0x12, 0x0d, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x0b, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x11, 0x2e, 0x53,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x1a,
-	0x12, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x65,
-	0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *ControllerDirective_EnqueueStops) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerDirective_EnqueueStops) ProtoMessage() {}
+
+func (x *ControllerDirective_EnqueueStops) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
+// Deprecated: Use ControllerDirective_EnqueueStops.ProtoReflect.Descriptor instead.
+func (*ControllerDirective_EnqueueStops) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *ControllerDirective_EnqueueStops) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *ControllerDirective_EnqueueStops) GetStops() []*Floor {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type ControllerDirective_InsertStop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	Position      uint32                 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Stop          *Floor                 `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControllerDirective_InsertStop) Reset() {
+	*x = ControllerDirective_InsertStop{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControllerDirective_InsertStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerDirective_InsertStop) ProtoMessage() {}
+
+func (x *ControllerDirective_InsertStop) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerDirective_InsertStop.ProtoReflect.Descriptor instead.
+func (*ControllerDirective_InsertStop) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *ControllerDirective_InsertStop) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+func (x *ControllerDirective_InsertStop) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ControllerDirective_InsertStop) GetStop() *Floor {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+type ControllerDirective_ClearStops struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Which         *Elevator              `protobuf:"bytes,1,opt,name=which,proto3" json:"which,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControllerDirective_ClearStops) Reset() {
+	*x = ControllerDirective_ClearStops{}
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControllerDirective_ClearStops) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerDirective_ClearStops) ProtoMessage() {}
+
+func (x *ControllerDirective_ClearStops) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerDirective_ClearStops.ProtoReflect.Descriptor instead.
+func (*ControllerDirective_ClearStops) Descriptor() ([]byte, []int) {
+	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *ControllerDirective_ClearStops) GetWhich() *Elevator {
+	if x != nil {
+		return x.Which
+	}
+	return nil
+}
+
+var File_pkg_ipc_grpc_telepathy_pb_telepathy_proto protoreflect.FileDescriptor
+
+const file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc = "" +
+	"\n" +
+	")pkg/ipc/grpc/telepathy/pb/telepathy.proto\"\x1c\n" +
+	"\n" +
+	"Controller\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x16\n" +
+	"\x04Tick\x12\x0e\n" +
+	"\x02v0\x18\x01 \x01(\x04R\x02v0\"0\n" +
+	"\bElevator\x12$\n" +
+	"\relevatorIndex\x18\x01 \x01(\rR\relevatorIndex\"'\n" +
+	"\x05Floor\x12\x1e\n" +
+	"\n" +
+	"floorIndex\x18\x01 \x01(\rR\n" +
+	"floorIndex\"\x99\x03\n" +
+	"\x0eElevatorStatus\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12\x1c\n" +
+	"\x05floor\x18\x02 \x01(\v2\x06.FloorR\x05floor\x12,\n" +
+	"\tdirection\x18\x03 \x01(\x0e2\x0e.CallDirectionR\tdirection\x12$\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x0e.ElevatorStateR\x05state\x12\x1e\n" +
+	"\x06target\x18\x05 \x01(\v2\x06.FloorR\x06target\x12\x12\n" +
+	"\x04load\x18\x06 \x01(\rR\x04load\x12\x1a\n" +
+	"\bcapacity\x18\a \x01(\rR\bcapacity\x12\"\n" +
+	"\bcarCalls\x18\b \x03(\v2\x06.FloorR\bcarCalls\x12$\n" +
+	"\x05fault\x18\t \x01(\x0e2\x0e.ElevatorFaultR\x05fault\x12$\n" +
+	"\titinerary\x18\n" +
+	" \x03(\v2\x06.FloorR\titinerary\x12\x16\n" +
+	"\x06riders\x18\v \x01(\rR\x06riders\x12\x1c\n" +
+	"\tratedLoad\x18\f \x01(\rR\tratedLoad\"\x8e\x01\n" +
+	"\x10SimulationNotice\x12#\n" +
+	"\x06target\x18\x01 \x01(\v2\v.ControllerR\x06target\x12&\n" +
+	"\x05event\x18\x02 \x03(\v2\x10.SimulationEventR\x05event\x12-\n" +
+	"\televators\x18\x03 \x03(\v2\x0f.ElevatorStatusR\televators\"\x80\f\n" +
+	"\x0fSimulationEvent\x12\x19\n" +
+	"\x04when\x18\x01 \x01(\v2\x05.TickR\x04when\x127\n" +
+	"\x06called\x18\x02 \x01(\v2\x1f.SimulationEvent.ElevatorCalledR\x06called\x12<\n" +
+	"\barriving\x18\x03 \x01(\v2 .SimulationEvent.ElevatorArrivedR\barriving\x12F\n" +
+	"\x0efloorSelection\x18\x04 \x01(\v2\x1e.SimulationEvent.FloorSelectedR\x0efloorSelection\x125\n" +
+	"\n" +
+	"initialize\x18\x05 \x01(\v2\x15.SimulationEvent.InitR\n" +
+	"initialize\x129\n" +
+	"\bdeferred\x18\x06 \x01(\v2\x1d.SimulationEvent.MoveDeferredR\bdeferred\x129\n" +
+	"\aservice\x18\a \x01(\v2\x1f.SimulationEvent.ServiceChangedR\aservice\x129\n" +
+	"\brejected\x18\b \x01(\v2\x1d.SimulationEvent.MoveRejectedR\brejected\x124\n" +
+	"\x06ticked\x18\t \x01(\v2\x1c.SimulationEvent.TickStartedR\x06ticked\x126\n" +
+	"\x05fault\x18\n" +
+	" \x01(\v2 .SimulationEvent.ControllerFaultR\x05fault\x1ab\n" +
+	"\x0eElevatorCalled\x12\"\n" +
+	"\bcalledAt\x18\x01 \x01(\v2\x06.FloorR\bcalledAt\x12,\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x0e.CallDirectionR\tdirection\x1a`\n" +
+	"\x0fElevatorArrived\x12%\n" +
+	"\barriving\x18\x01 \x01(\v2\t.ElevatorR\barriving\x12&\n" +
+	"\n" +
+	"atLocation\x18\x02 \x01(\v2\x06.FloorR\n" +
+	"atLocation\x1a^\n" +
+	"\rFloorSelected\x12)\n" +
+	"\n" +
+	"inElevator\x18\x01 \x01(\v2\t.ElevatorR\n" +
+	"inElevator\x12\"\n" +
+	"\bselected\x18\x02 \x01(\v2\x06.FloorR\bselected\x1a\xdf\x01\n" +
+	"\x04Init\x12$\n" +
+	"\rElevatorCount\x18\x01 \x01(\rR\rElevatorCount\x12\x1e\n" +
+	"\n" +
+	"FloorCount\x18\x02 \x01(\rR\n" +
+	"FloorCount\x12:\n" +
+	"\x06served\x18\x03 \x03(\v2\".SimulationEvent.Init.ServedFloorsR\x06served\x1aU\n" +
+	"\fServedFloors\x12%\n" +
+	"\belevator\x18\x01 \x01(\v2\t.ElevatorR\belevator\x12\x1e\n" +
+	"\x06floors\x18\x02 \x03(\v2\x06.FloorR\x06floors\x1aO\n" +
+	"\fMoveDeferred\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12\x1e\n" +
+	"\x06target\x18\x02 \x01(\v2\x06.FloorR\x06target\x1aW\n" +
+	"\x0eServiceChanged\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12$\n" +
+	"\x05fault\x18\x02 \x01(\x0e2\x0e.ElevatorFaultR\x05fault\x1au\n" +
+	"\fMoveRejected\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12\x1e\n" +
+	"\x06target\x18\x02 \x01(\v2\x06.FloorR\x06target\x12$\n" +
+	"\x05fault\x18\x03 \x01(\x0e2\x0e.ElevatorFaultR\x05fault\x1a\r\n" +
+	"\vTickStarted\x1a\x84\x01\n" +
+	"\x0fControllerFault\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12\x1f\n" +
+	"\x05which\x18\x02 \x01(\v2\t.ElevatorR\x05which\x12\x1e\n" +
+	"\x06target\x18\x03 \x01(\v2\x06.FloorR\x06target\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"C\n" +
+	"\x11ControllerUpdates\x12.\n" +
+	"\apending\x18\x01 \x03(\v2\x14.ControllerDirectiveR\apending\"\xc8\x04\n" +
+	"\x13ControllerDirective\x12\x19\n" +
+	"\x04when\x18\x01 \x01(\v2\x05.TickR\x04when\x129\n" +
+	"\tseekFloor\x18\x02 \x01(\v2\x1b.ControllerDirective.MoveToR\tseekFloor\x12;\n" +
+	"\aenqueue\x18\x03 \x01(\v2!.ControllerDirective.EnqueueStopsR\aenqueue\x127\n" +
+	"\x06insert\x18\x04 \x01(\v2\x1f.ControllerDirective.InsertStopR\x06insert\x125\n" +
+	"\x05clear\x18\x05 \x01(\v2\x1f.ControllerDirective.ClearStopsR\x05clear\x1aI\n" +
+	"\x06MoveTo\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12\x1e\n" +
+	"\x06target\x18\x02 \x01(\v2\x06.FloorR\x06target\x1aM\n" +
+	"\fEnqueueStops\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12\x1c\n" +
+	"\x05stops\x18\x02 \x03(\v2\x06.FloorR\x05stops\x1ae\n" +
+	"\n" +
+	"InsertStop\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\rR\bposition\x12\x1a\n" +
+	"\x04stop\x18\x03 \x01(\v2\x06.FloorR\x04stop\x1a-\n" +
+	"\n" +
+	"ClearStops\x12\x1f\n" +
+	"\x05which\x18\x01 \x01(\v2\t.ElevatorR\x05which\"\x0e\n" +
+	"\fSpawnOptions*_\n" +
+	"\rCallDirection\x12\x1e\n" +
+	"\x1aCALL_DIRECTION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CALL_DIRECTION_UP\x10\x01\x12\x17\n" +
+	"\x13CALL_DIRECTION_DOWN\x10\x02*\x80\x01\n" +
+	"\rElevatorFault\x12\x17\n" +
+	"\x13ELEVATOR_FAULT_NONE\x10\x00\x12\x1c\n" +
+	"\x18ELEVATOR_FAULT_BREAKDOWN\x10\x01\x12\x18\n" +
+	"\x14ELEVATOR_FAULT_DOORS\x10\x02\x12\x1e\n" +
+	"\x1aELEVATOR_FAULT_MAINTENANCE\x10\x03*\xc9\x01\n" +
+	"\rElevatorState\x12\x17\n" +
+	"\x13ELEVATOR_STATE_IDLE\x10\x00\x12\x1c\n" +
+	"\x18ELEVATOR_STATE_MOVING_UP\x10\x01\x12\x1e\n" +
+	"\x1aELEVATOR_STATE_MOVING_DOWN\x10\x02\x12 \n" +
+	"\x1cELEVATOR_STATE_DOORS_OPENING\x10\x03\x12\x1d\n" +
+	"\x19ELEVATOR_STATE_DOORS_OPEN\x10\x04\x12 \n" +
+	"\x1cELEVATOR_STATE_DOORS_CLOSING\x10\x052m\n" +
+	"\x11ControllerService\x12%\n" +
+	"\x05Spawn\x12\r.SpawnOptions\x1a\v.Controller\"\x00\x121\n" +
+	"\x06Notice\x12\x11.SimulationNotice\x1a\x12.ControllerUpdates\"\x00B\x13Z\x11grpc/telepathy/pbb\x06proto3"
+
 var (
 	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescOnce sync.Once
-	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescData = file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc
+	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescData []byte
 )
 
 func file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescGZIP() []byte {
 	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescOnce.Do(func() {
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescData)
+		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc), len(file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc)))
 	})
 	return file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDescData
 }
 
-var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_goTypes = []interface{}{
-	(*Controller)(nil),                      // 0: Controller
-	(*Tick)(nil),                            // 1: Tick
-	(*Elevator)(nil),                        // 2: Elevator
-	(*Floor)(nil),                           // 3: Floor
-	(*SimulationNotice)(nil),                // 4: SimulationNotice
-	(*SimulationEvent)(nil),                 // 5: SimulationEvent
-	(*ControllerUpdates)(nil),               // 6: ControllerUpdates
-	(*ControllerDirective)(nil),             // 7: ControllerDirective
-	(*SpawnOptions)(nil),                    // 8: SpawnOptions
-	(*SimulationEvent_ElevatorCalled)(nil),  // 9: SimulationEvent.ElevatorCalled
-	(*SimulationEvent_ElevatorArrived)(nil), // 10: SimulationEvent.ElevatorArrived
-	(*SimulationEvent_FloorSelected)(nil),   // 11: SimulationEvent.FloorSelected
-	(*SimulationEvent_Init)(nil),            // 12: SimulationEvent.Init
-	(*ControllerDirective_MoveTo)(nil),      // 13: ControllerDirective.MoveTo
+var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_goTypes = []any{
+	(CallDirection)(0),                        // 0: CallDirection
+	(ElevatorFault)(0),                        // 1: ElevatorFault
+	(ElevatorState)(0),                        // 2: ElevatorState
+	(*Controller)(nil),                        // 3: Controller
+	(*Tick)(nil),                              // 4: Tick
+	(*Elevator)(nil),                          // 5: Elevator
+	(*Floor)(nil),                             // 6: Floor
+	(*ElevatorStatus)(nil),                    // 7: ElevatorStatus
+	(*SimulationNotice)(nil),                  // 8: SimulationNotice
+	(*SimulationEvent)(nil),                   // 9: SimulationEvent
+	(*ControllerUpdates)(nil),                 // 10: ControllerUpdates
+	(*ControllerDirective)(nil),               // 11: ControllerDirective
+	(*SpawnOptions)(nil),                      // 12: SpawnOptions
+	(*SimulationEvent_ElevatorCalled)(nil),    // 13: SimulationEvent.ElevatorCalled
+	(*SimulationEvent_ElevatorArrived)(nil),   // 14: SimulationEvent.ElevatorArrived
+	(*SimulationEvent_FloorSelected)(nil),     // 15: SimulationEvent.FloorSelected
+	(*SimulationEvent_Init)(nil),              // 16: SimulationEvent.Init
+	(*SimulationEvent_MoveDeferred)(nil),      // 17: SimulationEvent.MoveDeferred
+	(*SimulationEvent_ServiceChanged)(nil),    // 18: SimulationEvent.ServiceChanged
+	(*SimulationEvent_MoveRejected)(nil),      // 19: SimulationEvent.MoveRejected
+	(*SimulationEvent_TickStarted)(nil),       // 20: SimulationEvent.TickStarted
+	(*SimulationEvent_ControllerFault)(nil),   // 21: SimulationEvent.ControllerFault
+	(*SimulationEvent_Init_ServedFloors)(nil), // 22: SimulationEvent.Init.ServedFloors
+	(*ControllerDirective_MoveTo)(nil),        // 23: ControllerDirective.MoveTo
+	(*ControllerDirective_EnqueueStops)(nil),  // 24: ControllerDirective.EnqueueStops
+	(*ControllerDirective_InsertStop)(nil),    // 25: ControllerDirective.InsertStop
+	(*ControllerDirective_ClearStops)(nil),    // 26: ControllerDirective.ClearStops
 }
 var file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_depIdxs = []int32{
-	0,  // 0: SimulationNotice.target:type_name -> Controller
-	5,  // 1: SimulationNotice.event:type_name -> SimulationEvent
-	1,  // 2: SimulationEvent.when:type_name -> Tick
-	9,  // 3: SimulationEvent.called:type_name -> SimulationEvent.ElevatorCalled
-	10, // 4: SimulationEvent.arriving:type_name -> SimulationEvent.ElevatorArrived
-	11, // 5: SimulationEvent.floorSelection:type_name -> SimulationEvent.FloorSelected
-	12, // 6: SimulationEvent.initialize:type_name -> SimulationEvent.Init
-	7,  // 7: ControllerUpdates.pending:type_name -> ControllerDirective
-	1,  // 8: ControllerDirective.when:type_name -> Tick
-	13, // 9: ControllerDirective.seekFloor:type_name -> ControllerDirective.MoveTo
-	3,  // 10: SimulationEvent.ElevatorCalled.calledAt:type_name -> Floor
-	2,  // 11: SimulationEvent.ElevatorArrived.arriving:type_name -> Elevator
-	3,  // 12: SimulationEvent.ElevatorArrived.atLocation:type_name -> Floor
-	2,  // 13: SimulationEvent.FloorSelected.inElevator:type_name -> Elevator
-	3,  // 14: SimulationEvent.FloorSelected.selected:type_name -> Floor
-	2,  // 15: ControllerDirective.MoveTo.which:type_name -> Elevator
-	3,  // 16: ControllerDirective.MoveTo.target:type_name -> Floor
-	8,  // 17: ControllerService.Spawn:input_type -> SpawnOptions
-	4,  // 18: ControllerService.Notice:input_type -> SimulationNotice
-	0,  // 19: ControllerService.Spawn:output_type -> Controller
-	6,  // 20: ControllerService.Notice:output_type -> ControllerUpdates
-	19, // [19:21] is the sub-list for method output_type
-	17, // [17:19] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	5,  // 0: ElevatorStatus.which:type_name -> Elevator
+	6,  // 1: ElevatorStatus.floor:type_name -> Floor
+	0,  // 2: ElevatorStatus.direction:type_name -> CallDirection
+	2,  // 3: ElevatorStatus.state:type_name -> ElevatorState
+	6,  // 4: ElevatorStatus.target:type_name -> Floor
+	6,  // 5: ElevatorStatus.carCalls:type_name -> Floor
+	1,  // 6: ElevatorStatus.fault:type_name -> ElevatorFault
+	6,  // 7: ElevatorStatus.itinerary:type_name -> Floor
+	3,  // 8: SimulationNotice.target:type_name -> Controller
+	9,  // 9: SimulationNotice.event:type_name -> SimulationEvent
+	7,  // 10: SimulationNotice.elevators:type_name -> ElevatorStatus
+	4,  // 11: SimulationEvent.when:type_name -> Tick
+	13, // 12: SimulationEvent.called:type_name -> SimulationEvent.ElevatorCalled
+	14, // 13: SimulationEvent.arriving:type_name -> SimulationEvent.ElevatorArrived
+	15, // 14: SimulationEvent.floorSelection:type_name -> SimulationEvent.FloorSelected
+	16, // 15: SimulationEvent.initialize:type_name -> SimulationEvent.Init
+	17, // 16: SimulationEvent.deferred:type_name -> SimulationEvent.MoveDeferred
+	18, // 17: SimulationEvent.service:type_name -> SimulationEvent.ServiceChanged
+	19, // 18: SimulationEvent.rejected:type_name -> SimulationEvent.MoveRejected
+	20, // 19: SimulationEvent.ticked:type_name -> SimulationEvent.TickStarted
+	21, // 20: SimulationEvent.fault:type_name -> SimulationEvent.ControllerFault
+	11, // 21: ControllerUpdates.pending:type_name -> ControllerDirective
+	4,  // 22: ControllerDirective.when:type_name -> Tick
+	23, // 23: ControllerDirective.seekFloor:type_name -> ControllerDirective.MoveTo
+	24, // 24: ControllerDirective.enqueue:type_name -> ControllerDirective.EnqueueStops
+	25, // 25: ControllerDirective.insert:type_name -> ControllerDirective.InsertStop
+	26, // 26: ControllerDirective.clear:type_name -> ControllerDirective.ClearStops
+	6,  // 27: SimulationEvent.ElevatorCalled.calledAt:type_name -> Floor
+	0,  // 28: SimulationEvent.ElevatorCalled.direction:type_name -> CallDirection
+	5,  // 29: SimulationEvent.ElevatorArrived.arriving:type_name -> Elevator
+	6,  // 30: SimulationEvent.ElevatorArrived.atLocation:type_name -> Floor
+	5,  // 31: SimulationEvent.FloorSelected.inElevator:type_name -> Elevator
+	6,  // 32: SimulationEvent.FloorSelected.selected:type_name -> Floor
+	22, // 33: SimulationEvent.Init.served:type_name -> SimulationEvent.Init.ServedFloors
+	5,  // 34: SimulationEvent.MoveDeferred.which:type_name -> Elevator
+	6,  // 35: SimulationEvent.MoveDeferred.target:type_name -> Floor
+	5,  // 36: SimulationEvent.ServiceChanged.which:type_name -> Elevator
+	1,  // 37: SimulationEvent.ServiceChanged.fault:type_name -> ElevatorFault
+	5,  // 38: SimulationEvent.MoveRejected.which:type_name -> Elevator
+	6,  // 39: SimulationEvent.MoveRejected.target:type_name -> Floor
+	1,  // 40: SimulationEvent.MoveRejected.fault:type_name -> ElevatorFault
+	5,  // 41: SimulationEvent.ControllerFault.which:type_name -> Elevator
+	6,  // 42: SimulationEvent.ControllerFault.target:type_name -> Floor
+	5,  // 43: SimulationEvent.Init.ServedFloors.elevator:type_name -> Elevator
+	6,  // 44: SimulationEvent.Init.ServedFloors.floors:type_name -> Floor
+	5,  // 45: ControllerDirective.MoveTo.which:type_name -> Elevator
+	6,  // 46: ControllerDirective.MoveTo.target:type_name -> Floor
+	5,  // 47: ControllerDirective.EnqueueStops.which:type_name -> Elevator
+	6,  // 48: ControllerDirective.EnqueueStops.stops:type_name -> Floor
+	5,  // 49: ControllerDirective.InsertStop.which:type_name -> Elevator
+	6,  // 50: ControllerDirective.InsertStop.stop:type_name -> Floor
+	5,  // 51: ControllerDirective.ClearStops.which:type_name -> Elevator
+	12, // 52: ControllerService.Spawn:input_type -> SpawnOptions
+	8,  // 53: ControllerService.Notice:input_type -> SimulationNotice
+	3,  // 54: ControllerService.Spawn:output_type -> Controller
+	10, // 55: ControllerService.Notice:output_type -> ControllerUpdates
+	54, // [54:56] is the sub-list for method output_type
+	52, // [52:54] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_init() }
@@ -900,192 +1805,22 @@ func file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_init() {
 	if File_pkg_ipc_grpc_telepathy_pb_telepathy_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Controller); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tick); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Elevator); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Floor); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationNotice); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerUpdates); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerDirective); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpawnOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationEvent_ElevatorCalled); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationEvent_ElevatorArrived); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationEvent_FloorSelected); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulationEvent_Init); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerDirective_MoveTo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc), len(file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_goTypes,
 		DependencyIndexes: file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_depIdxs,
+		EnumInfos:         file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_enumTypes,
 		MessageInfos:      file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_msgTypes,
 	}.Build()
 	File_pkg_ipc_grpc_telepathy_pb_telepathy_proto = out.File
-	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_rawDesc = nil
 	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_goTypes = nil
 	file_pkg_ipc_grpc_telepathy_pb_telepathy_proto_depIdxs = nil
 }
@@ -7,7 +7,19 @@ import (
 
 func doFloorCall(t *remoteController, msg *pb.SimulationEvent_ElevatorCalled) error {
 	id := simulator.FloorID(msg.CalledAt.FloorIndex)
+	direction := convertDirectionFromWire(msg.Direction)
 	//dispatch to client
-	t.controller.controller.Called(id)
+	t.controller.controller.Called(id, direction)
 	return nil
 }
+
+func convertDirectionFromWire(direction pb.CallDirection) simulator.Direction {
+	switch direction {
+	case pb.CallDirection_CALL_DIRECTION_UP:
+		return simulator.DirectionUp
+	case pb.CallDirection_CALL_DIRECTION_DOWN:
+		return simulator.DirectionDown
+	default:
+		return simulator.DirectionNone
+	}
+}
@@ -0,0 +1,18 @@
+package srv
+
+import (
+	"github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
+	"github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+func doMoveDeferred(t *remoteController, msg *pb.SimulationEvent_MoveDeferred) error {
+	observer, ok := t.controller.controller.(simulator.MoveDeferredObserver)
+	if !ok {
+		return nil
+	}
+	elevator := simulator.ElevatorID(msg.Which.ElevatorIndex)
+	floor := simulator.FloorID(msg.Target.FloorIndex)
+	//dispatch to client
+	observer.MoveDeferred(elevator, floor)
+	return nil
+}
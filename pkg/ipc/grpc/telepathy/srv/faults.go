@@ -0,0 +1,72 @@
+package srv
+
+import (
+	"github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
+	"github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+func doServiceChanged(t *remoteController, msg *pb.SimulationEvent_ServiceChanged) error {
+	observer, ok := t.controller.controller.(simulator.FaultObserver)
+	if !ok {
+		return nil
+	}
+	elevator := simulator.ElevatorID(msg.Which.ElevatorIndex)
+	fault := convertFaultFromWire(msg.Fault)
+	//dispatch to client
+	if fault == simulator.FaultNone {
+		observer.Restored(elevator)
+	} else {
+		observer.OutOfService(elevator, fault)
+	}
+	return nil
+}
+
+func doMoveRejected(t *remoteController, msg *pb.SimulationEvent_MoveRejected) error {
+	observer, ok := t.controller.controller.(simulator.MoveRejectedObserver)
+	if !ok {
+		return nil
+	}
+	elevator := simulator.ElevatorID(msg.Which.ElevatorIndex)
+	floor := simulator.FloorID(msg.Target.FloorIndex)
+	var err error
+	if fault := convertFaultFromWire(msg.Fault); fault != simulator.FaultNone {
+		err = &simulator.OutOfServiceError{Elevator: elevator, Fault: fault}
+	} else {
+		err = &simulator.UnservedFloorError{Elevator: elevator, Floor: floor}
+	}
+	//dispatch to client
+	observer.MoveRejected(elevator, floor, err)
+	return nil
+}
+
+func doControllerFault(t *remoteController, msg *pb.SimulationEvent_ControllerFault) error {
+	observer, ok := t.controller.controller.(simulator.ControllerFaultObserver)
+	if !ok {
+		return nil
+	}
+	floor := simulator.NoFloor
+	if msg.Target != nil {
+		floor = simulator.FloorID(msg.Target.FloorIndex)
+	}
+	//dispatch to client
+	observer.ControllerFault(&simulator.ControllerFaultError{
+		Command:  msg.Command,
+		Elevator: simulator.ElevatorID(msg.Which.GetElevatorIndex()),
+		Floor:    floor,
+		Reason:   msg.Reason,
+	})
+	return nil
+}
+
+func convertFaultFromWire(fault pb.ElevatorFault) simulator.FaultKind {
+	switch fault {
+	case pb.ElevatorFault_ELEVATOR_FAULT_BREAKDOWN:
+		return simulator.FaultBreakdown
+	case pb.ElevatorFault_ELEVATOR_FAULT_DOORS:
+		return simulator.FaultDoors
+	case pb.ElevatorFault_ELEVATOR_FAULT_MAINTENANCE:
+		return simulator.FaultMaintenance
+	default:
+		return simulator.FaultNone
+	}
+}
@@ -1,33 +1,170 @@
 package srv
 
 import (
-	"fmt"
+	pb2 "github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
 	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+	"log/slog"
 )
 
+// pendingDirective is a command issued by the controller awaiting delivery to the simulation.
+type pendingDirective interface {
+	toWire() *pb2.ControllerDirective
+}
+
 type pendingMove struct {
 	which simulator2.ElevatorID
 	to    simulator2.FloorID
 }
 
+func (p *pendingMove) toWire() *pb2.ControllerDirective {
+	return &pb2.ControllerDirective{
+		SeekFloor: &pb2.ControllerDirective_MoveTo{
+			Which:  &pb2.Elevator{ElevatorIndex: uint32(p.which)},
+			Target: &pb2.Floor{FloorIndex: uint32(p.to)},
+		},
+	}
+}
+
+type pendingStops struct {
+	which simulator2.ElevatorID
+	stops []simulator2.FloorID
+}
+
+func (p *pendingStops) toWire() *pb2.ControllerDirective {
+	stops := make([]*pb2.Floor, len(p.stops))
+	for i, stop := range p.stops {
+		stops[i] = &pb2.Floor{FloorIndex: uint32(stop)}
+	}
+	return &pb2.ControllerDirective{
+		Enqueue: &pb2.ControllerDirective_EnqueueStops{
+			Which: &pb2.Elevator{ElevatorIndex: uint32(p.which)},
+			Stops: stops,
+		},
+	}
+}
+
+type pendingInsert struct {
+	which    simulator2.ElevatorID
+	position int
+	stop     simulator2.FloorID
+}
+
+func (p *pendingInsert) toWire() *pb2.ControllerDirective {
+	return &pb2.ControllerDirective{
+		Insert: &pb2.ControllerDirective_InsertStop{
+			Which:    &pb2.Elevator{ElevatorIndex: uint32(p.which)},
+			Position: uint32(max(p.position, 0)),
+			Stop:     &pb2.Floor{FloorIndex: uint32(p.stop)},
+		},
+	}
+}
+
+type pendingClear struct {
+	which simulator2.ElevatorID
+}
+
+func (p *pendingClear) toWire() *pb2.ControllerDirective {
+	return &pb2.ControllerDirective{
+		Clear: &pb2.ControllerDirective_ClearStops{
+			Which: &pb2.Elevator{ElevatorIndex: uint32(p.which)},
+		},
+	}
+}
+
 type controllerInstance struct {
-	controller   simulator2.Controller
-	pending      []*pendingMove
-	maxElevators uint32
+	controller simulator2.Controller
+	pending    []pendingDirective
+	logger     *slog.Logger
+	// statuses describe the elevators as of the most recent notice.
+	statuses []simulator2.ElevatorStatus
+	// now is the tick of the event being delivered.
+	now simulator2.Tick
+	// floorCount is the number of floors within the building as announced on initialization.
+	floorCount int
 }
 
-func (c *controllerInstance) MoveTo(elevator simulator2.ElevatorID, floor simulator2.FloorID) {
-	if elevator < 0 || uint32(elevator) > c.maxElevators {
-		//TODO: Report problem
-		panic(fmt.Sprintf("no such elevator %d", elevator))
+// checkCommand verifies the elevator and floors of a command against the most recent notice.  Commands are queued
+// regardless so the simulation holds any fault against the controller.
+func (c *controllerInstance) checkCommand(command string, elevator simulator2.ElevatorID, floors ...simulator2.FloorID) error {
+	floor := simulator2.NoFloor
+	if len(floors) > 0 {
+		floor = floors[0]
+	}
+	if elevator < 0 || int(elevator) >= len(c.statuses) {
+		return &simulator2.ControllerFaultError{Command: command, Elevator: elevator, Floor: floor, Reason: "no such elevator"}
 	}
-	fmt.Printf("Queuing move of %d to %d\n", elevator, floor)
+	for _, floor := range floors {
+		if floor < 0 || int(floor) >= c.floorCount {
+			return &simulator2.ControllerFaultError{Command: command, Elevator: elevator, Floor: floor, Reason: "no such floor"}
+		}
+	}
+	return nil
+}
+
+func (c *controllerInstance) MoveTo(elevator simulator2.ElevatorID, floor simulator2.FloorID) error {
+	c.logger.Debug("queuing move", "elevator", elevator, "floor", floor)
 	c.pending = append(c.pending, &pendingMove{
 		which: elevator,
 		to:    floor,
 	})
+	return c.checkCommand("MoveTo", elevator, floor)
+}
+
+func (c *controllerInstance) EnqueueStops(elevator simulator2.ElevatorID, floors ...simulator2.FloorID) error {
+	c.logger.Debug("queuing stops", "elevator", elevator, "stops", floors)
+	c.pending = append(c.pending, &pendingStops{
+		which: elevator,
+		stops: append([]simulator2.FloorID(nil), floors...),
+	})
+	return c.checkCommand("EnqueueStops", elevator, floors...)
+}
+
+func (c *controllerInstance) InsertStop(elevator simulator2.ElevatorID, position int, floor simulator2.FloorID) error {
+	c.logger.Debug("queuing stop insertion", "elevator", elevator, "floor", floor, "position", position)
+	c.pending = append(c.pending, &pendingInsert{
+		which:    elevator,
+		position: position,
+		stop:     floor,
+	})
+	return c.checkCommand("InsertStop", elevator, floor)
+}
+
+func (c *controllerInstance) ClearStops(elevator simulator2.ElevatorID) error {
+	c.logger.Debug("queuing clearing of stops", "elevator", elevator)
+	c.pending = append(c.pending, &pendingClear{which: elevator})
+	return c.checkCommand("ClearStops", elevator)
+}
+
+// Itinerary is the elevator's itinerary as reported by the most recent notice.  Stops queued since are not reflected
+// until the next notice.
+func (c *controllerInstance) Itinerary(elevator simulator2.ElevatorID) ([]simulator2.FloorID, error) {
+	if elevator < 0 || int(elevator) >= len(c.statuses) {
+		return nil, &simulator2.ControllerFaultError{Command: "Itinerary", Elevator: elevator, Floor: simulator2.NoFloor, Reason: "no such elevator"}
+	}
+	return c.statuses[elevator].Itinerary, nil
+}
+
+// Status describes the elevator as reported by the most recent notice.
+func (c *controllerInstance) Status(elevator simulator2.ElevatorID) (simulator2.ElevatorStatus, error) {
+	if elevator < 0 || int(elevator) >= len(c.statuses) {
+		return simulator2.ElevatorStatus{Elevator: elevator}, &simulator2.ControllerFaultError{Command: "Status", Elevator: elevator, Floor: simulator2.NoFloor, Reason: "no such elevator"}
+	}
+	return c.statuses[elevator], nil
+}
+
+func (c *controllerInstance) Now() simulator2.Tick {
+	return c.now
+}
+
+func (c *controllerInstance) Statuses() []simulator2.ElevatorStatus {
+	return append([]simulator2.ElevatorStatus(nil), c.statuses...)
+}
+
+// Logger shares the service's logger with the hosted controller.
+func (c *controllerInstance) Logger() *slog.Logger {
+	return c.logger
 }
 
 func (c *controllerInstance) resetPending() {
-	c.pending = make([]*pendingMove, 0)
+	c.pending = make([]pendingDirective, 0)
 }
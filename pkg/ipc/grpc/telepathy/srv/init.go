@@ -7,13 +7,43 @@ import (
 
 func doInit(t *remoteController, msg *pb.SimulationEvent_Init) error {
 	elevatorCount := msg.ElevatorCount
-	t.controller.maxElevators = elevatorCount
 	//fake elevator ids for now
 	elevatorIDs := make([]simulator.ElevatorID, elevatorCount)
 	for i, _ := range elevatorIDs {
 		elevatorIDs[i] = simulator.ElevatorID(i)
 	}
+	t.controller.floorCount = int(msg.FloorCount)
 	//dispatch to client
+	if aware, ok := t.controller.controller.(simulator.BuildingController); ok {
+		aware.InitBuilding(simulator.NumberedBuilding(int(msg.FloorCount)))
+	}
+	if zoned, ok := t.controller.controller.(simulator.ZonedController); ok {
+		zoned.InitZones(convertServedFromWire(elevatorIDs, msg))
+	}
 	t.controller.controller.Init(elevatorIDs)
 	return nil
 }
+
+// convertServedFromWire builds the served floors of each elevator, treating elevators without an entry as serving every
+// floor.
+func convertServedFromWire(elevators []simulator.ElevatorID, msg *pb.SimulationEvent_Init) simulator.ServedFloors {
+	served := make(simulator.ServedFloors, len(elevators))
+	for _, entry := range msg.Served {
+		floors := make([]simulator.FloorID, len(entry.Floors))
+		for i, floor := range entry.Floors {
+			floors[i] = simulator.FloorID(floor.FloorIndex)
+		}
+		served[simulator.ElevatorID(entry.Elevator.ElevatorIndex)] = floors
+	}
+	for _, elevator := range elevators {
+		if _, ok := served[elevator]; ok {
+			continue
+		}
+		floors := make([]simulator.FloorID, msg.FloorCount)
+		for i := range floors {
+			floors[i] = simulator.FloorID(i)
+		}
+		served[elevator] = floors
+	}
+	return served
+}
@@ -3,9 +3,9 @@ package srv
 import (
 	"context"
 	"errors"
-	"fmt"
 	pb2 "github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
 	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+	"log/slog"
 	"time"
 )
 
@@ -20,21 +20,24 @@ type remoteController struct {
 	state   int8
 	Builder simulator2.ControllerFunc
 	Timeout time.Duration
+	logger  *slog.Logger
 
 	controller *controllerInstance
 }
 
-func newRemoteController(builder simulator2.ControllerFunc) *remoteController {
+func newRemoteController(builder simulator2.ControllerFunc, logger *slog.Logger) *remoteController {
 	return &remoteController{
 		state:   rcInit,
 		Builder: builder,
 		Timeout: time.Second * 30,
+		logger:  logger,
 	}
 }
 
 func (t *remoteController) Spawn(ctx context.Context, opts *pb2.SpawnOptions) (*pb2.Controller, error) {
 	controller := &controllerInstance{
-		pending: make([]*pendingMove, 0),
+		pending: make([]pendingDirective, 0),
+		logger:  t.logger.With("controller", 0),
 	}
 	controller.controller = t.Builder(controller)
 	t.controller = controller
@@ -47,14 +50,20 @@ func (t *remoteController) Notice(ctx context.Context, notice *pb2.SimulationNot
 		return nil, errors.New("bad id")
 	}
 
-	fmt.Printf("Events: %#v\n", notice.Event)
+	t.logger.Debug("notice", "controller", id, "events", len(notice.Event))
+	t.controller.statuses = convertStatusesFromWire(notice.Elevators)
 	for _, e := range notice.Event {
-		fmt.Println("Event")
+		t.controller.now = simulator2.Tick(e.When.GetV0())
 		if e.Initialize != nil {
 			if err := doInit(t, e.Initialize); err != nil {
 				return nil, err
 			}
 		}
+		if e.Ticked != nil {
+			if err := doTickStarted(t); err != nil {
+				return nil, err
+			}
+		}
 
 		if e.Called != nil {
 			if err := doFloorCall(t, e.Called); err != nil {
@@ -67,8 +76,28 @@ func (t *remoteController) Notice(ctx context.Context, notice *pb2.SimulationNot
 			}
 		}
 
+		if e.Deferred != nil {
+			if err := doMoveDeferred(t, e.Deferred); err != nil {
+				return nil, err
+			}
+		}
+		if e.Service != nil {
+			if err := doServiceChanged(t, e.Service); err != nil {
+				return nil, err
+			}
+		}
+		if e.Rejected != nil {
+			if err := doMoveRejected(t, e.Rejected); err != nil {
+				return nil, err
+			}
+		}
+		if e.Fault != nil {
+			if err := doControllerFault(t, e.Fault); err != nil {
+				return nil, err
+			}
+		}
+
 		if e.FloorSelection != nil {
-			fmt.Println("Floor selection")
 			elevator := e.FloorSelection.InElevator.ElevatorIndex
 			floor := e.FloorSelection.Selected.FloorIndex
 			t.controller.controller.FloorSelected(simulator2.ElevatorID(elevator), simulator2.FloorID(floor))
@@ -77,15 +106,8 @@ func (t *remoteController) Notice(ctx context.Context, notice *pb2.SimulationNot
 
 	out := make([]*pb2.ControllerDirective, len(t.controller.pending))
 	for i, e := range t.controller.pending {
-		elevator := uint32(e.which)
-		floor := uint32(e.to)
-		fmt.Printf("Move elevator %d to %d\n", elevator, floor)
-		out[i] = &pb2.ControllerDirective{
-			SeekFloor: &pb2.ControllerDirective_MoveTo{
-				Which:  &pb2.Elevator{ElevatorIndex: elevator},
-				Target: &pb2.Floor{FloorIndex: floor},
-			},
-		}
+		out[i] = e.toWire()
+		t.logger.Debug("directing", "controller", id, "directive", out[i])
 	}
 	t.controller.resetPending()
 	return &pb2.ControllerUpdates{Pending: out}, nil
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"log/slog"
 	"net"
 )
 
@@ -15,6 +16,8 @@ type config struct {
 	//publishHealthService , when true, will export the gRPC health protocol
 	// See https://github.com/grpc/grpc/blob/master/doc/health-checking.md for more details
 	publishHealthService bool
+	//logger receives diagnostic output of the service and the controllers it hosts
+	logger *slog.Logger
 }
 
 type Option func(c *config)
@@ -32,6 +35,14 @@ func DisableHealthService() Option {
 	}
 }
 
+// WithLogger directs diagnostic output of the service and its hosted controllers to the logger.  By default output is
+// discarded.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
 // Network is an abstraction for binding to a listener.  Closes the gap between real world usage of the code and testing
 // paths.
 type Network interface {
@@ -43,13 +54,14 @@ func RunControllerService(builder simulator.ControllerFunc, withOptions ...Optio
 	c := &config{
 		listenAt:             "localhost:9998",
 		publishHealthService: true,
+		logger:               slog.New(slog.DiscardHandler),
 	}
 	for _, o := range withOptions {
 		o(c)
 	}
 
 	l := &tcp{listenAt: c.listenAt}
-	return RunControllerOn(builder, l, func(server *grpc.Server) error {
+	return runControllerOn(builder, l, c.logger, func(server *grpc.Server) error {
 		if !c.publishHealthService {
 			return nil
 		}
@@ -62,8 +74,12 @@ func RunControllerService(builder simulator.ControllerFunc, withOptions ...Optio
 
 // RunControllerOn exports the given controller on the specified network address
 func RunControllerOn(builder simulator.ControllerFunc, on Network, otherServices ...func(server *grpc.Server) error) error {
+	return runControllerOn(builder, on, slog.New(slog.DiscardHandler), otherServices...)
+}
+
+func runControllerOn(builder simulator.ControllerFunc, on Network, logger *slog.Logger, otherServices ...func(server *grpc.Server) error) error {
 	s := grpc.NewServer()
-	pb.RegisterControllerServiceServer(s, newRemoteController(builder))
+	pb.RegisterControllerServiceServer(s, newRemoteController(builder, logger))
 	for _, otherService := range otherServices {
 		if err := otherService(s); err != nil {
 			return err
@@ -0,0 +1,52 @@
+package srv
+
+import (
+	"github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
+	"github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+func convertStatusesFromWire(wire []*pb.ElevatorStatus) []simulator.ElevatorStatus {
+	statuses := make([]simulator.ElevatorStatus, len(wire))
+	for i, status := range wire {
+		statuses[i] = simulator.ElevatorStatus{
+			Elevator:  simulator.ElevatorID(status.Which.GetElevatorIndex()),
+			Floor:     simulator.FloorID(status.Floor.GetFloorIndex()),
+			Direction: convertDirectionFromWire(status.Direction),
+			State:     convertStateFromWire(status.State),
+			Target:    simulator.FloorID(status.Target.GetFloorIndex()),
+			Riders:    int(status.Riders),
+			Capacity:  int(status.Capacity),
+			Load:      simulator.Load(status.Load),
+			RatedLoad: simulator.Load(status.RatedLoad),
+			CarCalls:  convertFloorsFromWire(status.CarCalls),
+			Itinerary: convertFloorsFromWire(status.Itinerary),
+			Fault:     convertFaultFromWire(status.Fault),
+		}
+	}
+	return statuses
+}
+
+func convertFloorsFromWire(wire []*pb.Floor) []simulator.FloorID {
+	floors := make([]simulator.FloorID, len(wire))
+	for i, floor := range wire {
+		floors[i] = simulator.FloorID(floor.FloorIndex)
+	}
+	return floors
+}
+
+func convertStateFromWire(state pb.ElevatorState) int {
+	switch state {
+	case pb.ElevatorState_ELEVATOR_STATE_MOVING_UP:
+		return simulator.MovingUp
+	case pb.ElevatorState_ELEVATOR_STATE_MOVING_DOWN:
+		return simulator.MovingDown
+	case pb.ElevatorState_ELEVATOR_STATE_DOORS_OPENING:
+		return simulator.DoorsOpening
+	case pb.ElevatorState_ELEVATOR_STATE_DOORS_OPEN:
+		return simulator.DoorsOpen
+	case pb.ElevatorState_ELEVATOR_STATE_DOORS_CLOSING:
+		return simulator.DoorsClosing
+	default:
+		return simulator.Idle
+	}
+}
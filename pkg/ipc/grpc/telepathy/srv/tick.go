@@ -0,0 +1,13 @@
+package srv
+
+import "github.com/meschbach/elevatinator/pkg/simulator"
+
+func doTickStarted(t *remoteController) error {
+	observer, ok := t.controller.controller.(simulator.TickObserver)
+	if !ok {
+		return nil
+	}
+	//dispatch to client
+	observer.Tick(t.controller.now)
+	return nil
+}
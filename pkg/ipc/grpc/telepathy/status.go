@@ -0,0 +1,53 @@
+package telepathy
+
+import (
+	pb2 "github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/pb"
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+func convertStatusesToWire(statuses []simulator2.ElevatorStatus) []*pb2.ElevatorStatus {
+	out := make([]*pb2.ElevatorStatus, len(statuses))
+	for i, status := range statuses {
+		calls := convertFloorsToWire(status.CarCalls)
+		out[i] = &pb2.ElevatorStatus{
+			Which:     &pb2.Elevator{ElevatorIndex: uint32(status.Elevator)},
+			Floor:     &pb2.Floor{FloorIndex: uint32(status.Floor)},
+			Direction: convertDirectionToWire(status.Direction),
+			State:     convertStateToWire(status.State),
+			Target:    &pb2.Floor{FloorIndex: uint32(status.Target)},
+			Riders:    uint32(status.Riders),
+			Capacity:  uint32(status.Capacity),
+			Load:      uint32(status.Load),
+			RatedLoad: uint32(status.RatedLoad),
+			CarCalls:  calls,
+			Itinerary: convertFloorsToWire(status.Itinerary),
+			Fault:     convertFaultToWire(status.Fault),
+		}
+	}
+	return out
+}
+
+func convertFloorsToWire(floors []simulator2.FloorID) []*pb2.Floor {
+	out := make([]*pb2.Floor, len(floors))
+	for i, floor := range floors {
+		out[i] = &pb2.Floor{FloorIndex: uint32(floor)}
+	}
+	return out
+}
+
+func convertStateToWire(state int) pb2.ElevatorState {
+	switch state {
+	case simulator2.MovingUp:
+		return pb2.ElevatorState_ELEVATOR_STATE_MOVING_UP
+	case simulator2.MovingDown:
+		return pb2.ElevatorState_ELEVATOR_STATE_MOVING_DOWN
+	case simulator2.DoorsOpening:
+		return pb2.ElevatorState_ELEVATOR_STATE_DOORS_OPENING
+	case simulator2.DoorsOpen:
+		return pb2.ElevatorState_ELEVATOR_STATE_DOORS_OPEN
+	case simulator2.DoorsClosing:
+		return pb2.ElevatorState_ELEVATOR_STATE_DOORS_CLOSING
+	default:
+		return pb2.ElevatorState_ELEVATOR_STATE_IDLE
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/meschbach/elevatinator/pkg/ipc/grpc/telepathy/srv"
 	"github.com/meschbach/elevatinator/pkg/junk/grpctest"
 	"github.com/meschbach/elevatinator/pkg/scenarios"
+	"github.com/meschbach/elevatinator/pkg/simulator"
 	"github.com/stretchr/testify/require"
 	"net"
 	"testing"
@@ -31,15 +32,15 @@ func TestSystem(t *testing.T) {
 	controller := landing.ControllerAdapter()
 
 	t.Run("Single Person Up", func(t *testing.T) {
-		scenarios.RunScenario(controller, scenarios.SinglePersonUp)
+		require.True(t, scenarios.RunScenario(controller, scenarios.SinglePersonUp).Completed)
 	})
 
 	t.Run("Single Person Down", func(t *testing.T) {
-		scenarios.RunScenario(controller, scenarios.SinglePersonDown)
+		require.True(t, scenarios.RunScenario(controller, scenarios.SinglePersonDown).Completed)
 	})
 
 	t.Run("Multiple players and back", func(t *testing.T) {
-		scenarios.RunScenario(controller, scenarios.MultipleUpAndBack)
+		require.True(t, scenarios.RunScenario(controller, scenarios.MultipleUpAndBack).Completed)
 	})
 }
 
@@ -50,3 +51,218 @@ type testNetwork struct {
 func (t *testNetwork) Listener() (net.Listener, error) {
 	return t.transport.Listener, nil
 }
+
+// observingController wraps the queue controller, capturing the elevator status visible as floors are selected.
+type observingController struct {
+	simulator.Controller
+	elevators simulator.ControlledElevators
+	observed  chan simulator.ElevatorStatus
+}
+
+func (o *observingController) FloorSelected(elevatorID simulator.ElevatorID, floor simulator.FloorID) {
+	status, _ := o.elevators.Status(elevatorID)
+	o.observed <- status
+	o.Controller.FloorSelected(elevatorID, floor)
+}
+
+func TestElevatorStatusCrossesBridge(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(done)
+
+	observed := make(chan simulator.ElevatorStatus, 16)
+	virtualNetwork := &testNetwork{transport: grpctest.NewBufferTransport()}
+	go func() {
+		err := srv.RunControllerOn(func(elevators simulator.ControlledElevators) simulator.Controller {
+			return &observingController{Controller: queue.NewController(elevators), elevators: elevators, observed: observed}
+		}, virtualNetwork)
+		require.NoError(t, err)
+	}()
+
+	conn, err := virtualNetwork.transport.GRPCClient(ctx)
+	require.NoError(t, err)
+	landing := telepathy.LandingWithConnection(conn)
+	scenarios.RunScenario(landing.ControllerAdapter(), scenarios.SinglePersonUp)
+
+	status := <-observed
+	require.Equal(t, simulator.ElevatorID(0), status.Elevator)
+	require.Equal(t, simulator.FloorID(0), status.Floor)
+	require.Equal(t, simulator.DoorsClosing, status.State)
+	require.Equal(t, 1, status.Riders)
+	require.Equal(t, simulator.DefaultActorLoad, status.Load)
+	require.Equal(t, []simulator.FloorID{4}, status.CarCalls)
+}
+
+// tickingController wraps the queue controller, capturing each tick given to it.
+type tickingController struct {
+	simulator.Controller
+	ticks chan simulator.Tick
+}
+
+func (c *tickingController) Tick(tick simulator.Tick) {
+	c.ticks <- tick
+}
+
+func TestTicksCrossBridge(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(done)
+
+	ticks := make(chan simulator.Tick, 64)
+	virtualNetwork := &testNetwork{transport: grpctest.NewBufferTransport()}
+	go func() {
+		err := srv.RunControllerOn(func(elevators simulator.ControlledElevators) simulator.Controller {
+			return &tickingController{Controller: queue.NewController(elevators), ticks: ticks}
+		}, virtualNetwork)
+		require.NoError(t, err)
+	}()
+
+	conn, err := virtualNetwork.transport.GRPCClient(ctx)
+	require.NoError(t, err)
+	landing := telepathy.LandingWithConnection(conn)
+	scenarios.RunScenario(landing.ControllerAdapter(), scenarios.SinglePersonUp)
+	close(ticks)
+
+	expected := simulator.Tick(0)
+	for tick := range ticks {
+		require.Equal(t, expected, tick)
+		expected++
+	}
+	require.Greater(t, expected, simulator.Tick(1))
+}
+
+// itineraryController serves every call and selection by appending it to the itinerary of the sole elevator.
+type itineraryController struct {
+	elevators simulator.ControlledElevators
+	planned   chan []simulator.FloorID
+}
+
+func (c *itineraryController) Init(elevators []simulator.ElevatorID) {}
+func (c *itineraryController) Called(floor simulator.FloorID, direction simulator.Direction) {
+	c.elevators.EnqueueStops(0, floor)
+}
+func (c *itineraryController) FloorSelected(elevatorID simulator.ElevatorID, floor simulator.FloorID) {
+	c.elevators.EnqueueStops(elevatorID, floor)
+}
+func (c *itineraryController) CompletedMove(elevatorID simulator.ElevatorID) {}
+func (c *itineraryController) Tick(tick simulator.Tick) {
+	if plan, err := c.elevators.Itinerary(0); err == nil && len(plan) > 0 {
+		c.planned <- plan
+	}
+}
+
+func TestItineraryCrossesBridge(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(done)
+
+	planned := make(chan []simulator.FloorID, 64)
+	virtualNetwork := &testNetwork{transport: grpctest.NewBufferTransport()}
+	go func() {
+		err := srv.RunControllerOn(func(elevators simulator.ControlledElevators) simulator.Controller {
+			return &itineraryController{elevators: elevators, planned: planned}
+		}, virtualNetwork)
+		require.NoError(t, err)
+	}()
+
+	conn, err := virtualNetwork.transport.GRPCClient(ctx)
+	require.NoError(t, err)
+	landing := telepathy.LandingWithConnection(conn)
+	scenarios.TestScenario(t, landing.ControllerAdapter(), scenarios.SinglePersonUp)
+
+	require.NotEmpty(t, planned)
+	require.Equal(t, []simulator.FloorID{4}, <-planned)
+}
+
+// faultingController serves calls with the sole elevator while also directing an elevator which does not exist.
+type faultingController struct {
+	itineraryController
+	faults chan error
+}
+
+func (c *faultingController) Called(floor simulator.FloorID, direction simulator.Direction) {
+	c.elevators.MoveTo(7, floor)
+	c.itineraryController.Called(floor, direction)
+}
+func (c *faultingController) Tick(tick simulator.Tick) {}
+func (c *faultingController) ControllerFault(err error) {
+	c.faults <- err
+}
+
+func TestControllerFaultCrossesBridge(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(done)
+
+	faults := make(chan error, 64)
+	virtualNetwork := &testNetwork{transport: grpctest.NewBufferTransport()}
+	go func() {
+		err := srv.RunControllerOn(func(elevators simulator.ControlledElevators) simulator.Controller {
+			return &faultingController{itineraryController: itineraryController{elevators: elevators}, faults: faults}
+		}, virtualNetwork)
+		require.NoError(t, err)
+	}()
+
+	conn, err := virtualNetwork.transport.GRPCClient(ctx)
+	require.NoError(t, err)
+	landing := telepathy.LandingWithConnection(conn)
+	scenarios.TestScenario(t, landing.ControllerAdapter(), scenarios.SinglePersonUp)
+
+	require.NotEmpty(t, faults)
+	var fault *simulator.ControllerFaultError
+	require.ErrorAs(t, <-faults, &fault)
+	require.Equal(t, "MoveTo", fault.Command)
+	require.Equal(t, simulator.ElevatorID(7), fault.Elevator)
+}
+
+func TestUnreachableControllerIsDisqualified(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(done)
+
+	virtualNetwork := &testNetwork{transport: grpctest.NewBufferTransport()}
+	go func() {
+		require.NoError(t, srv.RunControllerOn(queue.NewController, virtualNetwork))
+	}()
+
+	conn, err := virtualNetwork.transport.GRPCClient(ctx)
+	require.NoError(t, err)
+	landing := telepathy.LandingWithConnection(conn)
+	require.NoError(t, conn.Close())
+
+	result := scenarios.RunScenario(landing.ControllerAdapter(), scenarios.WithControllerFaultPolicy(scenarios.SinglePersonUp, simulator.DisqualifyControllerFaults))
+	require.False(t, result.Completed)
+	require.NotNil(t, result.Disqualification)
+	require.Equal(t, "Spawn", result.Disqualification.Command)
+	require.Equal(t, 1, result.Score.ControllerFaults)
+}
+
+// buildingController wraps the queue controller, capturing the building it is initialized with.
+type buildingController struct {
+	simulator.Controller
+	floors chan int
+}
+
+func (b *buildingController) InitBuilding(building simulator.Building) {
+	b.floors <- len(building.Floors)
+}
+
+func TestFloorCountCrossesBridge(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(done)
+
+	floors := make(chan int, 1)
+	virtualNetwork := &testNetwork{transport: grpctest.NewBufferTransport()}
+	go func() {
+		err := srv.RunControllerOn(func(elevators simulator.ControlledElevators) simulator.Controller {
+			return &buildingController{Controller: queue.NewController(elevators), floors: floors}
+		}, virtualNetwork)
+		require.NoError(t, err)
+	}()
+
+	conn, err := virtualNetwork.transport.GRPCClient(ctx)
+	require.NoError(t, err)
+	landing := telepathy.LandingWithConnection(conn)
+	scenarios.TestScenario(t, landing.ControllerAdapter(), func(simulation *simulator.Simulation) simulator.Tick {
+		simulation.Initialize(1, 8)
+		simulation.AttachActor(simulator.NewActor(0, 7, 0))
+		return 40
+	})
+
+	require.Equal(t, 8, <-floors)
+}
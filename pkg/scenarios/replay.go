@@ -0,0 +1,90 @@
+package scenarios
+
+import (
+	"fmt"
+
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+// Divergence describes the first point at which a replayed run no longer matches the recording.
+type Divergence struct {
+	// Index is the position of the diverging event within the recorded stream.
+	Index int
+	// Tick is the simulation tick the divergence occurred within.  Divergences during initialization are reported at
+	// tick -1.
+	Tick simulator2.Tick
+	// Expected is the recorded event, or nil if the replay produced more events than were recorded.
+	Expected *simulator2.Event
+	// Actual is the replayed event, or nil if the replay produced fewer events than were recorded.
+	Actual *simulator2.Event
+}
+
+func (d *Divergence) Error() string {
+	describe := func(e *simulator2.Event) string {
+		if e == nil {
+			return "no event"
+		}
+		return e.ToString()
+	}
+	return fmt.Sprintf("replay diverged at event %d in tick %d: expected %s, got %s", d.Index, d.Tick, describe(d.Expected), describe(d.Actual))
+}
+
+// replayVerifier compares the events produced by a simulation against a recorded stream, retaining the first
+// divergence.
+type replayVerifier struct {
+	recorded   []simulator2.Event
+	index      int
+	tick       simulator2.Tick
+	divergence *Divergence
+}
+
+func (r *replayVerifier) OnControllerEvent(event simulator2.Event) {
+	if event.EventType == simulator2.TickStart {
+		r.tick = event.Timestamp
+	}
+	if r.divergence == nil {
+		if r.index >= len(r.recorded) {
+			actual := event
+			r.divergence = &Divergence{Index: r.index, Tick: r.tick, Actual: &actual}
+		} else if expected := r.recorded[r.index]; expected != event {
+			actual := event
+			r.divergence = &Divergence{Index: r.index, Tick: r.tick, Expected: &expected, Actual: &actual}
+		}
+	}
+	r.index++
+}
+
+// Record runs the scenario against the controller produced via the factory, capturing the event stream for later
+// replay.
+func Record(factory simulator2.ControllerFunc, scenario Scenario) []simulator2.Event {
+	stream := simulator2.NewEventLog()
+
+	simulation := simulator2.NewSimulation()
+	simulation.AttachControllerListener(stream)
+	maxTicks := scenario(simulation)
+	simulation.AttachControllerFunc(factory)
+	simulation.TickUpTo(maxTicks)
+	return stream.Events
+}
+
+// Replay re-drives the scenario against the controller produced via the factory, verifying each event produced matches
+// the recorded stream.  The first difference is returned as a *Divergence, otherwise nil is returned when the replay
+// reproduces the recording exactly.
+func Replay(recorded []simulator2.Event, factory simulator2.ControllerFunc, scenario Scenario) error {
+	verifier := &replayVerifier{recorded: recorded, tick: -1}
+
+	simulation := simulator2.NewSimulation()
+	simulation.AttachControllerListener(verifier)
+	maxTicks := scenario(simulation)
+	simulation.AttachControllerFunc(factory)
+	simulation.TickUpTo(maxTicks)
+
+	if verifier.divergence != nil {
+		return verifier.divergence
+	}
+	if verifier.index < len(recorded) {
+		expected := recorded[verifier.index]
+		return &Divergence{Index: verifier.index, Tick: verifier.tick, Expected: &expected}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package scenarios
+
+import (
+	"errors"
+	"testing"
+
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+func TestReplayReproducesRecording(t *testing.T) {
+	recorded := Record(simulator2.NewMoveController, SinglePersonUp)
+	if err := Replay(recorded, simulator2.NewMoveController, SinglePersonUp); err != nil {
+		t.Errorf("Expected replay to match recording, got %s", err)
+	}
+}
+
+func TestReplayReportsFirstDivergence(t *testing.T) {
+	recorded := Record(simulator2.NewMoveController, SinglePersonUp)
+	index := -1
+	for i, e := range recorded {
+		if e.EventType == simulator2.ElevatorFloorRequest {
+			index = i
+			recorded[i].Floor = 3
+			break
+		}
+	}
+	if index < 0 {
+		t.Fatalf("Expected recording to contain a floor request")
+	}
+
+	err := Replay(recorded, simulator2.NewMoveController, SinglePersonUp)
+	var divergence *Divergence
+	if !errors.As(err, &divergence) {
+		t.Fatalf("Expected a divergence, got %v", err)
+	}
+	if divergence.Index != index {
+		t.Errorf("Expected divergence at event %d, got %d", index, divergence.Index)
+	}
+	if divergence.Expected.Floor != 3 || divergence.Actual.Floor != 4 {
+		t.Errorf("Expected divergence between floor 3 and 4, got %s", divergence)
+	}
+}
+
+func TestReplayReportsMissingEvents(t *testing.T) {
+	recorded := Record(simulator2.NewMoveController, SinglePersonUp)
+	recorded = append(recorded, simulator2.OnTickStart(99))
+
+	err := Replay(recorded, simulator2.NewMoveController, SinglePersonUp)
+	var divergence *Divergence
+	if !errors.As(err, &divergence) {
+		t.Fatalf("Expected a divergence, got %v", err)
+	}
+	if divergence.Index != len(recorded)-1 || divergence.Actual != nil {
+		t.Errorf("Expected missing final event, got %s", divergence)
+	}
+}
@@ -0,0 +1,232 @@
+package scenarios
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+// Reporter writes out the results of scenario runs.
+type Reporter interface {
+	Report(out io.Writer, results []Result) error
+}
+
+// ReporterFor picks the reporter for a format: text, json or junit.
+func ReporterFor(format string) (Reporter, error) {
+	switch strings.ToLower(format) {
+	case "text", "":
+		return TextReporter{}, nil
+	case "json":
+		return JSONReporter{}, nil
+	case "junit":
+		return JUnitReporter{Suite: "scenarios"}, nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q, expected text, json or junit", format)
+	}
+}
+
+// TextReporter writes a human readable summary of each run, followed by the event log of runs which were not
+// completed.  As with TestScenario, the event log marks the end of each tick rather than listing the tick events.
+type TextReporter struct{}
+
+func (TextReporter) Report(out io.Writer, results []Result) error {
+	for _, result := range results {
+		if result.Name != "" {
+			if _, err := fmt.Fprintf(out, "== %s ==\n", result.Name); err != nil {
+				return err
+			}
+		}
+		if err := writeText(out, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeText(out io.Writer, result Result) error {
+	if labeled(result.Building) {
+		if _, err := fmt.Fprintf(out, "Floors: %s (lobby %s)\n", strings.Join(result.Building.Floors, " "), result.Building.Label(result.Building.Lobby)); err != nil {
+			return err
+		}
+	}
+	if result.Completed {
+		_, err := fmt.Fprintf(out, "WIN!!! All actors completed objectives at tick %d\nScore: %s\n", result.Tick, result.Score)
+		return err
+	}
+	if _, err := fmt.Fprintf(out, ":-( Some actors did not make it to their objectives @ tick %d\n", result.Tick); err != nil {
+		return err
+	}
+	if result.Disqualification != nil {
+		if _, err := fmt.Fprintf(out, "Disqualified: %s\n", result.Disqualification); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(out, "Score: %s\n", result.Score); err != nil {
+		return err
+	}
+	if len(result.Events) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(out, "Event stream:"); err != nil {
+		return err
+	}
+	for _, e := range result.Events {
+		var err error
+		switch e.EventType {
+		case simulator2.TickStart:
+			//do nothing
+		case simulator2.TickDone:
+			_, err = fmt.Fprintf(out, "-- Tick %d --\n", e.Timestamp)
+		default:
+			_, err = fmt.Fprintf(out, "\t- %s\n", e.ToString())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// labeled is true when the building names its floors other than by their index, such as with basements, warranting the
+// floors being listed alongside the run.
+func labeled(building simulator2.Building) bool {
+	if building.Lobby != 0 {
+		return true
+	}
+	for i, label := range building.Floors {
+		if label != strconv.Itoa(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONReporter writes the results as a JSON array.  Events are written by name alongside their description.
+type JSONReporter struct{}
+
+// jsonResult adds the recorded events to a Result, which are otherwise omitted as the simulator's events encode their
+// types as numbers.
+type jsonResult struct {
+	Result
+	Events []jsonEvent `json:"events,omitempty"`
+}
+
+type jsonEvent struct {
+	EventType   string                `json:"eventType"`
+	Tick        simulator2.Tick       `json:"tick"`
+	Entity      simulator2.EntityID   `json:"entity"`
+	Elevator    simulator2.ElevatorID `json:"elevator"`
+	Floor       simulator2.FloorID    `json:"floor"`
+	FloorLabel  string                `json:"floorLabel,omitempty"`
+	Description string                `json:"description"`
+}
+
+func (JSONReporter) Report(out io.Writer, results []Result) error {
+	encoded := make([]jsonResult, 0, len(results))
+	for _, result := range results {
+		r := jsonResult{Result: result}
+		for _, e := range result.Events {
+			r.Events = append(r.Events, jsonEvent{
+				EventType:   e.EventType.String(),
+				Tick:        e.Timestamp,
+				Entity:      e.Entity,
+				Elevator:    e.Elevator,
+				Floor:       e.Floor,
+				FloorLabel:  e.FloorLabel,
+				Description: e.ToString(),
+			})
+		}
+		encoded = append(encoded, r)
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(encoded)
+}
+
+// JUnitReporter writes the results as a JUnit XML test suite for continuous integration systems, with each run being
+// a test case.  Runs which were not completed are failures, carrying their event log as output.
+type JUnitReporter struct {
+	// Suite names the test suite.
+	Suite string
+}
+
+type junitSuite struct {
+	XMLName  xml.Name    `xml:"testsuite"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut *junitOutput  `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",cdata"`
+}
+
+type junitOutput struct {
+	Text string `xml:",cdata"`
+}
+
+func (j JUnitReporter) Report(out io.Writer, results []Result) error {
+	suite := junitSuite{Name: j.Suite, Tests: len(results)}
+	for i, result := range results {
+		name := result.Name
+		if name == "" {
+			name = fmt.Sprintf("scenario-%d", i)
+		}
+		testCase := junitCase{Name: name, ClassName: j.Suite}
+		if !result.Completed {
+			suite.Failures++
+			message := fmt.Sprintf("Some actors did not make it to their objectives @ tick %d of %d", result.Tick, result.MaxTicks)
+			if result.Disqualification != nil {
+				message = fmt.Sprintf("Disqualified @ tick %d: %s", result.Tick, result.Disqualification)
+			}
+			testCase.Failure = &junitFailure{Message: message, Text: fmt.Sprintf("Score: %s", result.Score)}
+		}
+		var log strings.Builder
+		if err := writeText(&log, result); err != nil {
+			return err
+		}
+		testCase.SystemOut = &junitOutput{Text: log.String()}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
+
+// ReportTo writes the results to the file at path, or to stdout when path is empty.
+func ReportTo(reporter Reporter, path string, results []Result) error {
+	if path == "" {
+		return reporter.Report(os.Stdout, results)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := reporter.Report(file, results); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,179 @@
+package scenarios
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+// idleController never moves an elevator, leaving every actor waiting.
+func idleController(elevators simulator2.ControlledElevators) simulator2.Controller {
+	return &idle{}
+}
+
+type idle struct{}
+
+func (i *idle) Init(elevators []simulator2.ElevatorID)                                   {}
+func (i *idle) Called(floor simulator2.FloorID, direction simulator2.Direction)          {}
+func (i *idle) FloorSelected(elevatorID simulator2.ElevatorID, floor simulator2.FloorID) {}
+func (i *idle) CompletedMove(elevatorID simulator2.ElevatorID)                           {}
+
+func TestRunScenarioProducesResult(t *testing.T) {
+	result := RunScenario(simulator2.NewMoveController, SinglePersonUp, Named("single-up"))
+	if !result.Completed || result.Name != "single-up" || result.MaxTicks != 20 || result.Tick > result.MaxTicks {
+		t.Errorf("Expected a completed run within the allowed ticks, got %+v", result)
+	}
+	if len(result.Actors) != 1 || result.Actors[0].Outcome != Finished || len(result.Actors[0].Journeys) != 1 {
+		t.Errorf("Expected the sole actor to have finished, got %+v", result.Actors)
+	}
+	if result.Score.Completed != 1 {
+		t.Errorf("Expected the score of the run, got %s", result.Score)
+	}
+	if result.Events != nil {
+		t.Errorf("Expected events to only be recorded when requested")
+	}
+}
+
+func TestRunScenarioRecordsUndeliveredActors(t *testing.T) {
+	result := RunScenario(idleController, SinglePersonUp, WithEventLog())
+	if result.Completed || result.Tick != result.MaxTicks {
+		t.Errorf("Expected the run to exhaust the allowed ticks, got %+v", result)
+	}
+	if len(result.Actors) != 1 || result.Actors[0].Outcome != Undelivered {
+		t.Errorf("Expected the sole actor to be undelivered, got %+v", result.Actors)
+	}
+	if len(result.Events) == 0 {
+		t.Errorf("Expected the event log to be recorded")
+	}
+}
+
+func TestReportersDescribeResults(t *testing.T) {
+	results := []Result{
+		RunScenario(simulator2.NewMoveController, SinglePersonUp, Named("single-up")),
+		RunScenario(idleController, SinglePersonDown, Named("single-down"), WithEventLog()),
+	}
+
+	var text bytes.Buffer
+	if err := (TextReporter{}).Report(&text, results); err != nil {
+		t.Fatalf("Unable to write text report: %s", err)
+	}
+	if !strings.Contains(text.String(), "WIN!!!") || !strings.Contains(text.String(), "Event stream:") {
+		t.Errorf("Expected the text report to describe both runs, got %s", text.String())
+	}
+	if strings.Contains(text.String(), "TickStart") || strings.Contains(text.String(), "TickDone") || !strings.Contains(text.String(), "-- Tick 0 --") {
+		t.Errorf("Expected the text report to mark the end of each tick rather than list tick events, got %s", text.String())
+	}
+
+	var encoded bytes.Buffer
+	if err := (JSONReporter{}).Report(&encoded, results); err != nil {
+		t.Fatalf("Unable to write JSON report: %s", err)
+	}
+	var decoded []struct {
+		Name      string `json:"name"`
+		Completed bool   `json:"completed"`
+		Score     struct {
+			Completed int `json:"completed"`
+			Journey   struct {
+				Max simulator2.Tick `json:"max"`
+			} `json:"journey"`
+		} `json:"score"`
+		Actors []struct {
+			Journeys []struct {
+				ArrivedAt simulator2.Tick `json:"arrivedAt"`
+			} `json:"journeys"`
+		} `json:"actors"`
+		Events []struct {
+			EventType string `json:"eventType"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+		t.Fatalf("Unable to parse JSON report: %s", err)
+	}
+	if len(decoded) != 2 || !decoded[0].Completed || decoded[1].Completed || len(decoded[1].Events) == 0 || decoded[1].Events[0].EventType == "" {
+		t.Errorf("Expected the JSON report to describe both runs, got %+v", decoded)
+	}
+	if decoded[0].Score.Completed != 1 || decoded[0].Score.Journey.Max == 0 || decoded[0].Actors[0].Journeys[0].ArrivedAt <= 0 {
+		t.Errorf("Expected the score and journeys to be reported in camelCase, got %s", encoded.String())
+	}
+
+	var junit bytes.Buffer
+	if err := (JUnitReporter{Suite: "scenarios"}).Report(&junit, results); err != nil {
+		t.Fatalf("Unable to write JUnit report: %s", err)
+	}
+	var suite junitSuite
+	if err := xml.Unmarshal(junit.Bytes(), &suite); err != nil {
+		t.Fatalf("Unable to parse JUnit report: %s", err)
+	}
+	if suite.Tests != 2 || suite.Failures != 1 || suite.Cases[0].Failure != nil || suite.Cases[1].Failure == nil || suite.Cases[1].Name != "single-down" {
+		t.Errorf("Expected the JUnit report to fail the undelivered run, got %+v", suite)
+	}
+}
+
+func TestReportersLabelFloors(t *testing.T) {
+	results := []Result{RunScenario(idleController, BasementCommute, Named("basement-commute"), WithEventLog())}
+	if len(results[0].Building.Floors) != len(OfficeTower.Floors) || results[0].Building.Lobby != OfficeTower.Lobby {
+		t.Fatalf("Expected the result to carry the building, got %+v", results[0].Building)
+	}
+
+	var text bytes.Buffer
+	if err := (TextReporter{}).Report(&text, results); err != nil {
+		t.Fatalf("Unable to write text report: %s", err)
+	}
+	if !strings.Contains(text.String(), "Floors: B2 B1 L 1 2 3 4 P (lobby L)") || !strings.Contains(text.String(), "ElevatorCall, B1 going") {
+		t.Errorf("Expected the text report to label floors, got %s", text.String())
+	}
+
+	var encoded bytes.Buffer
+	if err := (JSONReporter{}).Report(&encoded, results); err != nil {
+		t.Fatalf("Unable to write JSON report: %s", err)
+	}
+	var decoded []struct {
+		Building struct {
+			Floors []string `json:"floors"`
+		} `json:"building"`
+		Events []struct {
+			EventType  string `json:"eventType"`
+			FloorLabel string `json:"floorLabel"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+		t.Fatalf("Unable to parse JSON report: %s", err)
+	}
+	called := false
+	for _, e := range decoded[0].Events {
+		called = called || (e.EventType == "ElevatorCalled" && e.FloorLabel == "B1")
+	}
+	if len(decoded[0].Building.Floors) != len(OfficeTower.Floors) || !called {
+		t.Errorf("Expected the JSON report to label floors, got %s", encoded.String())
+	}
+
+	var junit bytes.Buffer
+	if err := (JUnitReporter{Suite: "scenarios"}).Report(&junit, results); err != nil {
+		t.Fatalf("Unable to write JUnit report: %s", err)
+	}
+	var suite junitSuite
+	if err := xml.Unmarshal(junit.Bytes(), &suite); err != nil {
+		t.Fatalf("Unable to parse JUnit report: %s", err)
+	}
+	if len(suite.Cases) != 1 || !strings.Contains(suite.Cases[0].SystemOut.Text, "(lobby L)") {
+		t.Errorf("Expected the JUnit report to label floors, got %+v", suite)
+	}
+
+	var numbered bytes.Buffer
+	if err := (TextReporter{}).Report(&numbered, []Result{RunScenario(simulator2.NewMoveController, SinglePersonUp)}); err != nil {
+		t.Fatalf("Unable to write text report: %s", err)
+	}
+	if strings.Contains(numbered.String(), "Floors:") {
+		t.Errorf("Expected numbered buildings to not list their floors, got %s", numbered.String())
+	}
+}
+
+func TestReporterForRejectsUnknownFormats(t *testing.T) {
+	if _, err := ReporterFor("yaml"); err == nil {
+		t.Errorf("Expected an unknown format to be rejected")
+	}
+}
@@ -0,0 +1,111 @@
+package scenarios
+
+import (
+	"errors"
+
+	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
+)
+
+// Outcome is how an actor's itinerary ended by the close of a run.
+type Outcome string
+
+const (
+	// Finished actors arrived at the floor of every leg.
+	Finished Outcome = "finished"
+	// Abandoned actors gave up waiting for an elevator.
+	Abandoned Outcome = "abandoned"
+	// Undelivered actors were still travelling, or had yet to set off, when the run ended.
+	Undelivered Outcome = "undelivered"
+)
+
+// ActorOutcome is the fate of a single actor within a run.
+type ActorOutcome struct {
+	// Entity identifies the actor within the simulation, or -1 if the actor never started.
+	Entity  simulator2.EntityID `json:"entity"`
+	Outcome Outcome             `json:"outcome"`
+	// Journeys is the timeline of each leg of the actor's itinerary.
+	Journeys []simulator2.Journey `json:"journeys"`
+}
+
+// Result describes a completed run of a scenario.
+type Result struct {
+	// Name identifies the scenario within reports, empty unless given via Named.
+	Name string `json:"name,omitempty"`
+	// Completed is true when every actor reached their objectives without any abandoning them.
+	Completed bool `json:"completed"`
+	// Tick is the tick the run ended at, with MaxTicks being the most the scenario allowed.
+	Tick     simulator2.Tick `json:"tick"`
+	MaxTicks simulator2.Tick `json:"maxTicks"`
+	// Building names the floors of the scenario, such as the basements and lobby, for reports to label floors by.
+	Building simulator2.Building `json:"building"`
+	Actors   []ActorOutcome      `json:"actors"`
+	Score    simulator2.Score    `json:"score"`
+	// Disqualification is the fault which disqualified the controller, if any.
+	Disqualification *simulator2.ControllerFaultError `json:"disqualification,omitempty"`
+	// Events are every event of the run, only recorded when requested via WithEventLog.
+	Events []simulator2.Event `json:"-"`
+}
+
+// RunOption customizes a run of a scenario.
+type RunOption func(r *run)
+
+type run struct {
+	name   string
+	events *simulator2.EventLog
+}
+
+// Named gives the name of the scenario for reports.
+func Named(name string) RunOption {
+	return func(r *run) {
+		r.name = name
+	}
+}
+
+// WithEventLog records every event of the run within the Result.
+func WithEventLog() RunOption {
+	return func(r *run) {
+		r.events = simulator2.NewEventLog()
+	}
+}
+
+// resultOf gathers the Result of the simulation once it has run for the given number of ticks.
+func resultOf(r *run, simulation *simulator2.Simulation, tick, maxTicks simulator2.Tick) Result {
+	score := simulation.Score()
+	result := Result{
+		Name:      r.name,
+		Completed: simulation.ActorsCompletedObjectives() && score.Abandoned == 0,
+		Tick:      tick,
+		MaxTicks:  maxTicks,
+		Building:  simulation.Building(),
+		Actors:    actorOutcomes(simulation.Journeys()),
+		Score:     score,
+	}
+	var fault *simulator2.ControllerFaultError
+	if errors.As(simulation.Disqualification(), &fault) {
+		result.Disqualification = fault
+	}
+	if r.events != nil {
+		result.Events = r.events.Events
+	}
+	return result
+}
+
+// actorOutcomes groups the journeys by actor, relying upon each actor's legs being listed together starting with the
+// first leg.
+func actorOutcomes(journeys []simulator2.Journey) []ActorOutcome {
+	var outcomes []ActorOutcome
+	for _, journey := range journeys {
+		if journey.Leg == 0 || len(outcomes) == 0 {
+			outcomes = append(outcomes, ActorOutcome{Entity: journey.Entity, Outcome: Finished})
+		}
+		actor := &outcomes[len(outcomes)-1]
+		actor.Journeys = append(actor.Journeys, journey)
+		switch {
+		case journey.Abandoned():
+			actor.Outcome = Abandoned
+		case !journey.Completed() && actor.Outcome == Finished:
+			actor.Outcome = Undelivered
+		}
+	}
+	return outcomes
+}
@@ -1,7 +1,6 @@
 package scenarios
 
 import (
-	"fmt"
 	simulator2 "github.com/meschbach/elevatinator/pkg/simulator"
 	"testing"
 )
@@ -10,46 +9,58 @@ import (
 // allow a controller to run for all simulation.Actors to win.
 type Scenario func(simulation *simulator2.Simulation) simulator2.Tick
 
-// RunScenario runs the given scenario against the controller produced via the factory.  If the controller completes the
-// scenario in less than the maximum allowed ticks then the count is produced.  Otherwise the event log from the run is
-// written out.
-func RunScenario(factory simulator2.ControllerFunc, scenario Scenario) {
-	stream := simulator2.NewEventLog()
+// Observed wraps the scenario to attach the listener before the simulation is configured, allowing the listener to
+// receive every event of the run.
+func Observed(scenario Scenario, listener simulator2.ControllerListener) Scenario {
+	return func(simulation *simulator2.Simulation) simulator2.Tick {
+		simulation.AttachControllerListener(listener)
+		return scenario(simulation)
+	}
+}
+
+// WithControllerFaultPolicy wraps the scenario to decide the consequences of the controller issuing commands the
+// simulation is unable to carry out.
+func WithControllerFaultPolicy(scenario Scenario, policy simulator2.ControllerFaultPolicy) Scenario {
+	return func(simulation *simulator2.Simulation) simulator2.Tick {
+		simulation.SetControllerFaultPolicy(policy)
+		return scenario(simulation)
+	}
+}
+
+// RunScenario runs the given scenario against the controller produced via the factory, producing the Result of the
+// run for a Reporter to write out.
+func RunScenario(factory simulator2.ControllerFunc, scenario Scenario, options ...RunOption) Result {
+	r := &run{}
+	for _, option := range options {
+		option(r)
+	}
 
 	simulation := simulator2.NewSimulation()
-	simulation.AttachControllerListener(stream)
+	if r.events != nil {
+		simulation.AttachControllerListener(r.events)
+	}
 	maxTicks := scenario(simulation)
 	simulation.AttachControllerFunc(factory)
 
 	tick := simulation.TickUpTo(maxTicks)
-	if simulation.ActorsCompletedObjectives() {
-		fmt.Printf("WIN!!! All actors completed objectives at tick %d\n", tick)
-	} else {
-		fmt.Printf(":-( Some actors did not make it to their objectives @ tick %d\n", tick)
-		fmt.Println("Event stream:")
-		for _, e := range stream.Events {
-			fmt.Printf("\t- %s\n", e.ToString())
-		}
-	}
+	return resultOf(r, simulation, tick, maxTicks)
 }
 
 // TestScenario integrates with Go's built-in testing framework to assert a given controller is able to complete the
 // given scenario.  This is useful for functional level integration testing with Controllers.
 func TestScenario(t *testing.T, factory simulator2.ControllerFunc, scenario Scenario) {
-	stream := simulator2.NewEventLog()
-
-	simulation := simulator2.NewSimulation()
-	simulation.AttachControllerListener(stream)
-	maxTicks := scenario(simulation)
-	simulation.AttachControllerFunc(factory)
-
-	tick := simulation.TickUpTo(maxTicks)
-	if simulation.ActorsCompletedObjectives() {
-		t.Logf("WIN!!! All actors completed objectives at tick %d", tick)
+	result := RunScenario(factory, scenario, WithEventLog())
+	if result.Completed {
+		t.Logf("WIN!!! All actors completed objectives at tick %d", result.Tick)
+		t.Logf("Score: %s", result.Score)
 	} else {
-		t.Errorf(":-( Some actors did not make it to their objectives @ tick %d", tick)
+		t.Errorf(":-( Some actors did not make it to their objectives @ tick %d", result.Tick)
+		if result.Disqualification != nil {
+			t.Logf("Disqualified: %s", result.Disqualification)
+		}
+		t.Logf("Score: %s", result.Score)
 		t.Logf("Event stream:")
-		for _, e := range stream.Events {
+		for _, e := range result.Events {
 			switch e.EventType {
 			case simulator2.TickStart:
 				//do nothing
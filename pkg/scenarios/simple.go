@@ -31,3 +31,97 @@ func MultipleUpAndBack(simulation *simulator2.Simulation) simulator2.Tick {
 	simulation.Initialize(1, 5)
 	return 40
 }
+
+// OfficeTower is the floor plan used by BasementCommute: two levels of basement parking below the lobby, four office
+// floors and a penthouse.
+var OfficeTower = simulator2.Building{
+	Floors: []string{"B2", "B1", "L", "1", "2", "3", "4", "P"},
+	Lobby:  2,
+}
+
+// BasementCommute is a scenario where actors travel between the basement parking, the lobby and the upper floors of
+// OfficeTower, requiring a controller to service floors below the lobby.
+func BasementCommute(simulation *simulator2.Simulation) simulator2.Tick {
+	floor := func(label string) int {
+		return int(OfficeTower.MustFloor(label))
+	}
+	simulation.AttachActor(simulator2.NewActor(floor("3"), floor("B1"), 0))
+	simulation.AttachActor(simulator2.NewActor(floor("P"), floor("L"), 4))
+	simulation.AttachActor(simulator2.NewActor(floor("B2"), floor("4"), 12))
+	simulation.InitializeBuilding(OfficeTower, []simulator2.ElevatorConfig{simulator2.DefaultElevatorConfig()})
+	return 60
+}
+
+// DayInTheLife is a scenario where tenants of OfficeTower arrive for work, head out for lunch and back, then leave for
+// the day, each scored per leg of their day.
+func DayInTheLife(simulation *simulator2.Simulation) simulator2.Tick {
+	floor := func(label string) int {
+		return int(OfficeTower.MustFloor(label))
+	}
+	simulation.AttachActor(simulator2.NewActorWithItinerary(floor("B1"), 0, []simulator2.Leg{
+		{Floor: floor("3"), Dwell: 20},
+		{Floor: floor("L"), Dwell: 10},
+		{Floor: floor("3"), Dwell: 20},
+		{Floor: floor("B1")},
+	}))
+	simulation.AttachActor(simulator2.NewActorWithItinerary(floor("L"), 4, []simulator2.Leg{
+		{Floor: floor("1"), Dwell: 15},
+		{Floor: floor("P"), Dwell: 10},
+		{Floor: floor("1"), Dwell: 25},
+		{Floor: floor("L")},
+	}))
+	simulation.AttachActor(simulator2.NewActorWithItinerary(floor("B2"), 10, []simulator2.Leg{
+		{Floor: floor("4"), Dwell: 30},
+		{Floor: floor("B2")},
+	}))
+	simulation.InitializeBuilding(OfficeTower, []simulator2.ElevatorConfig{simulator2.DefaultElevatorConfig()})
+	return 200
+}
+
+// MixedCrowd is a scenario where OfficeTower is visited by people going about it in their own ways: a party of visitors
+// travelling together, a tenant taking the stairs for a single floor, a courier repeatedly pressing their button and a
+// tenant only boarding cars heading their way.
+func MixedCrowd(simulation *simulator2.Simulation) simulator2.Tick {
+	floor := func(label string) int {
+		return int(OfficeTower.MustFloor(label))
+	}
+	visitors := simulator2.Group(3, simulator2.DefaultBehavior{})
+	for i := 0; i < 3; i++ {
+		simulation.AttachActor(simulator2.NewActor(floor("4"), floor("L"), simulator2.Tick(i*2), simulator2.WithBehavior(visitors)))
+	}
+	simulation.AttachActor(simulator2.NewActor(floor("1"), floor("L"), 1, simulator2.WithBehavior(simulator2.TakesStairs(1, 4, simulator2.DefaultBehavior{}))))
+	simulation.AttachActor(simulator2.NewActor(floor("P"), floor("B1"), 3, simulator2.WithBehavior(simulator2.Repressing(3, simulator2.DefaultBehavior{}))))
+	simulation.AttachActor(simulator2.NewActor(floor("L"), floor("3"), 8, simulator2.WithBehavior(simulator2.GoingMyWay(simulator2.DefaultBehavior{}))))
+	simulation.InitializeBuilding(OfficeTower, []simulator2.ElevatorConfig{simulator2.DefaultElevatorConfig()})
+	return 120
+}
+
+// FreightAndPassengers is a scenario where a single service car rated for a ton carries a pallet of freight up from the
+// loading dock alongside a person with a cart, a wheelchair user and other tenants of OfficeTower.  Riders who would
+// overload the car must wait for a later trip.
+func FreightAndPassengers(simulation *simulator2.Simulation) simulator2.Tick {
+	floor := func(label string) int {
+		return int(OfficeTower.MustFloor(label))
+	}
+	simulation.AttachActor(simulator2.NewActor(floor("3"), floor("B1"), 0, simulator2.WithLoad(800)))
+	simulation.AttachActor(simulator2.NewActor(floor("2"), floor("B1"), 0, simulator2.WithLoad(180)))
+	simulation.AttachActor(simulator2.NewActor(floor("4"), floor("B1"), 1))
+	simulation.AttachActor(simulator2.NewActor(floor("P"), floor("L"), 5, simulator2.WithLoad(150)))
+	simulation.AttachActor(simulator2.NewActor(floor("1"), floor("L"), 6))
+	car := simulator2.DefaultElevatorConfig()
+	car.Capacity = 8
+	car.RatedLoad = 1000
+	simulation.InitializeBuilding(OfficeTower, []simulator2.ElevatorConfig{car})
+	return 120
+}
+
+// StuckBetweenFloors is a scenario where the elevator breaks down while carrying an actor up, trapping them until the
+// car is repaired.  A controller must resume service once the elevator is restored.
+func StuckBetweenFloors(simulation *simulator2.Simulation) simulator2.Tick {
+	simulation.AttachActor(simulator2.NewActor(4, 0, 0))
+	simulation.Initialize(1, 5)
+	if err := simulation.ScheduleFault(simulator2.Fault{Elevator: 0, Kind: simulator2.FaultBreakdown, At: 5, Duration: 8}); err != nil {
+		panic(err)
+	}
+	return 30
+}
@@ -1,13 +1,52 @@
 package simulator
 
+// Leg is a single trip within an actor's itinerary.
+type Leg struct {
+	// Floor is where the actor travels to.
+	Floor int `json:"floor"`
+	// Dwell is the number of ticks the actor remains on the floor after arriving before setting off on the next leg.
+	Dwell Tick `json:"dwell"`
+}
+
 type Actor struct {
-	floorGoal         int
-	startingFloor     int
-	startingTick      Tick
+	// floorGoal is the floor of the current leg.
+	floorGoal     int
+	startingFloor int
+	startingTick  Tick
+	// legs is the itinerary of the actor, with leg being the index of the current leg.
+	legs []Leg
+	leg  int
+	// completedLegs are the journeys of the legs the actor has already travelled.
+	completedLegs []Journey
+	// dwellUntil is the tick a dwelling actor sets off on their next leg.
+	dwellUntil Tick
+
+	// calledTick, boardedTick, completedGoalTick and abandonedTick time the current leg.
+	calledTick        Tick
+	boardedTick       Tick
 	completedGoalTick Tick
+	abandonedTick     Tick
+	// patience is the number of ticks the actor will wait on a floor before giving up.  Zero waits indefinitely.
+	patience Tick
+	// waitingSince is the tick the actor began waiting on their current floor or boarded their current car.
+	waitingSince Tick
+	// legGoal is the floor the actor is currently riding to, either the floor of the current leg or a floor to transfer
+	// cars at.
+	legGoal int
 
 	state   int
 	actorID int
+	// refusedBy tracks the elevators which turned the actor away while they remain on the actor's floor.
+	refusedBy []int
+	// passedOver tracks the elevators the actor chose not to board, or which do not serve the floor they are riding to,
+	// while they remain on the actor's floor.
+	passedOver []int
+	// behavior makes the choices of the actor.
+	behavior Behavior
+	// load is how much of a car the actor takes up, such as a wheelchair user or freight taking up more than a person.
+	load Load
+	// walkingUntil is the tick an actor on the stairs arrives at their goal.
+	walkingUntil Tick
 }
 
 const (
@@ -16,29 +55,101 @@ const (
 	WaitingOnFloor
 	EnteringElevator
 	WaitingInElevator
+	Abandoned
+	// Dwelling actors have arrived at the floor of a leg and are spending time there before the next leg.
+	Dwelling
+	// Walking actors are taking the stairs to the floor of their current leg.
+	Walking
 )
 
+// ActorOption customizes an Actor at construction.
+type ActorOption func(a *Actor)
+
+// WithPatience limits how many ticks an actor will wait on a floor for an elevator before abandoning their objective.
+func WithPatience(ticks Tick) ActorOption {
+	return func(a *Actor) {
+		a.patience = ticks
+	}
+}
+
+// WithBehavior has the behavior make the choices of the actor in place of DefaultBehavior.
+func WithBehavior(behavior Behavior) ActorOption {
+	return func(a *Actor) {
+		a.behavior = behavior
+	}
+}
+
+// WithLoad has the actor take up the given load within a car in place of DefaultActorLoad.
+func WithLoad(load Load) ActorOption {
+	return func(a *Actor) {
+		a.load = load
+	}
+}
+
 func (a *Actor) Tick(simulation *Simulation, tick Tick) {
 	switch a.state {
-	case Finished:
+	case Finished, Abandoned:
 		return
 	case Unstarted:
 		if tick < a.startingTick {
 			return
 		}
 		a.actorID = simulation.StartAt(a, a.startingFloor)
-		simulation.callElevator(a.startingFloor)
-		a.state = WaitingOnFloor
+		a.calledTick = simulation.tick
+		a.waitOn(simulation, a.startingFloor)
 	case WaitingOnFloor:
+		floor := simulation.actorFloor(a.actorID)
+		if a.forgetDepartedElevators(simulation) {
+			simulation.callElevator(a.actorID, floor, DirectionBetween(FloorID(floor), FloorID(a.legGoal)))
+		}
 		elevatorIDs := simulation.ElevatorsAt(a.actorID)
-		if len(elevatorIDs) == 0 {
-			return
+		for _, elevatorID := range elevatorIDs {
+			if containsElevator(a.refusedBy, elevatorID) {
+				continue
+			}
+			status, elevator := a.status(floor, simulation.tick), simulation.status(ElevatorID(elevatorID))
+			if !simulation.elevatorServes(elevatorID, a.legGoal) || !a.behavior.Boards(status, elevator) {
+				if !containsElevator(a.passedOver, elevatorID) {
+					a.passedOver = append(a.passedOver, elevatorID)
+				}
+				continue
+			}
+			entered := simulation.Enter(a.actorID, elevatorID)
+			a.behavior.Entered(status, elevator, entered)
+			if entered {
+				a.refusedBy = a.refusedBy[:0]
+				a.passedOver = a.passedOver[:0]
+				a.waitingSince = simulation.tick
+				a.state = EnteringElevator
+				return
+			}
+			a.refusedBy = append(a.refusedBy, elevatorID)
+		}
+		if a.patience > 0 && simulation.tick-a.waitingSince >= a.patience {
+			a.abandonedTick = simulation.tick
+			a.state = Abandoned
+			simulation.abandon(a.actorID)
 		}
-		simulation.Enter(a.actorID, elevatorIDs[0])
-		a.state = EnteringElevator
 	case EnteringElevator:
-		simulation.PressButton(a.actorID, a.floorGoal)
+		simulation.PressButton(a.actorID, a.legGoal)
 		a.state = WaitingInElevator
+	case WaitingInElevator:
+		elevator := simulation.status(ElevatorID(simulation.actorElevator(a.actorID)))
+		if a.behavior.PressesAgain(a.status(int(elevator.Floor), simulation.tick), elevator) {
+			simulation.PressButton(a.actorID, a.legGoal)
+		}
+	case Walking:
+		if tick < a.walkingUntil {
+			return
+		}
+		simulation.leaveStairs(a.actorID)
+		a.arrived(simulation, tick)
+	case Dwelling:
+		if tick < a.dwellUntil {
+			return
+		}
+		a.calledTick = simulation.tick
+		a.waitOn(simulation, simulation.actorFloor(a.actorID))
 	default:
 	}
 }
@@ -46,25 +157,181 @@ func (a *Actor) Tick(simulation *Simulation, tick Tick) {
 func (a *Actor) elevatorStopped(simulation *Simulation, tick Tick, floor int) {
 	switch a.state {
 	case WaitingInElevator:
-		if simulation.ElevatorAtFloor(a.actorID, FloorID(a.floorGoal)) {
-			a.completedGoalTick = tick
-			a.state = Finished
-			simulation.exitElevator(a.actorID)
-			simulation.dispatchControllerEvent(OnActorFinished(simulation.tick, 1))
+		if !simulation.ElevatorAtFloor(a.actorID, FloorID(a.legGoal)) {
+			return
+		}
+		if !simulation.exitElevator(a.actorID) {
+			return
+		}
+		if a.legGoal != a.floorGoal {
+			a.waitOn(simulation, a.legGoal)
+			return
+		}
+		a.arrived(simulation, tick)
+	}
+}
+
+// arrived completes the current leg once the actor is on its floor, finishing the actor after the final leg.
+func (a *Actor) arrived(simulation *Simulation, tick Tick) {
+	a.completedGoalTick = tick
+	if a.leg+1 < len(a.legs) {
+		a.startNextLeg(tick)
+		return
+	}
+	a.state = Finished
+	simulation.dispatchControllerEvent(OnActorFinished(simulation.tick, EntityID(a.actorID), FloorID(a.floorGoal), 1))
+}
+
+// startNextLeg records the journey of the leg just completed and has the actor dwell on the floor before the next leg.
+func (a *Actor) startNextLeg(tick Tick) {
+	a.completedLegs = append(a.completedLegs, a.journey())
+	a.dwellUntil = tick + a.legs[a.leg].Dwell
+	a.leg++
+	a.floorGoal = a.legs[a.leg].Floor
+	a.legGoal = a.floorGoal
+	a.calledTick = -1
+	a.boardedTick = -1
+	a.completedGoalTick = -1
+	a.state = Dwelling
+}
+
+// waitOn has the actor wait on the floor, calling for a car towards the next leg of their journey unless their behavior
+// takes the stairs instead.  Legs to the floor the actor is already on are arrived at straight away.
+func (a *Actor) waitOn(simulation *Simulation, floor int) {
+	if floor == a.floorGoal {
+		a.arrived(simulation, simulation.tick)
+		return
+	}
+	a.legGoal = simulation.routeLeg(floor, a.floorGoal)
+	a.waitingSince = simulation.tick
+	if ticks, walks := a.behavior.Walks(a.status(floor, simulation.tick)); walks {
+		a.walk(simulation, ticks)
+		return
+	}
+	a.state = WaitingOnFloor
+	simulation.callElevator(a.actorID, floor, DirectionBetween(FloorID(floor), FloorID(a.legGoal)))
+}
+
+// walk has the actor take the stairs to the floor of the current leg.  The climb counts as the ride of the leg.
+func (a *Actor) walk(simulation *Simulation, ticks Tick) {
+	if a.boardedTick == -1 {
+		a.boardedTick = simulation.tick
+	}
+	a.walkingUntil = simulation.tick + ticks
+	a.state = Walking
+	simulation.takeStairs(a.actorID, a.floorGoal)
+}
+
+// status describes the actor on the given floor for their behavior.
+func (a *Actor) status(floor int, now Tick) ActorStatus {
+	return ActorStatus{
+		Entity:  EntityID(a.actorID),
+		Floor:   FloorID(floor),
+		Goal:    FloorID(a.floorGoal),
+		LegGoal: FloorID(a.legGoal),
+		Load:    a.load,
+		Elapsed: now - a.waitingSince,
+		Now:     now,
+	}
+}
+
+func containsElevator(elevatorIDs []int, elevatorID int) bool {
+	for _, id := range elevatorIDs {
+		if id == elevatorID {
+			return true
+		}
+	}
+	return false
+}
+
+// forgetDepartedElevators drops refusals and passed over cars which are no longer stopped on the floor, allowing the
+// actor to consider them again upon their return.  True is returned if any such elevator has departed, in which case the actor
+// should call for an elevator again.
+func (a *Actor) forgetDepartedElevators(simulation *Simulation) bool {
+	departed := false
+	for _, elevators := range []*[]int{&a.refusedBy, &a.passedOver} {
+		retained := (*elevators)[:0]
+		for _, id := range *elevators {
+			if simulation.elevatorOnActorsFloor(a.actorID, id) {
+				retained = append(retained, id)
+			}
 		}
+		departed = departed || len(retained) < len(*elevators)
+		*elevators = retained
+	}
+	return departed
+}
+
+// journey is the timeline of the current leg.
+func (a *Actor) journey() Journey {
+	return Journey{
+		Entity:      EntityID(a.actorID),
+		Leg:         a.leg,
+		CalledAt:    a.calledTick,
+		BoardedAt:   a.boardedTick,
+		ArrivedAt:   a.completedGoalTick,
+		AbandonedAt: a.abandonedTick,
 	}
 }
 
+// journeys is the timeline of every leg of the actor's itinerary.  Legs which are never set off on because the actor
+// abandoned an earlier leg are considered abandoned as well.  Actors without an itinerary have no journeys.
+func (a *Actor) journeys() []Journey {
+	if len(a.legs) == 0 {
+		return nil
+	}
+	journeys := append(make([]Journey, 0, len(a.legs)), a.completedLegs...)
+	journeys = append(journeys, a.journey())
+	for leg := a.leg + 1; leg < len(a.legs); leg++ {
+		journeys = append(journeys, Journey{
+			Entity:      EntityID(a.actorID),
+			Leg:         leg,
+			CalledAt:    -1,
+			BoardedAt:   -1,
+			ArrivedAt:   -1,
+			AbandonedAt: a.abandonedTick,
+		})
+	}
+	return journeys
+}
+
+// done is true once the actor has been resolved, either by reaching their goal or abandoning it.
 func (a *Actor) done() bool {
-	return a.state == Finished
+	return a.state == Finished || a.state == Abandoned
 }
 
-func NewActor(goal int, startingFloor int, startingTick Tick) *Actor {
-	return &Actor{
+func NewActor(goal int, startingFloor int, startingTick Tick, options ...ActorOption) *Actor {
+	return NewActorWithItinerary(startingFloor, startingTick, []Leg{{Floor: goal}}, options...)
+}
+
+// NewActorWithItinerary creates an actor who enters the building on the starting floor then travels to each floor of the
+// itinerary in turn, calling for an elevator once they have dwelled on a floor.  The actor is finished once they arrive
+// at the floor of the final leg.  An actor given an empty itinerary has nowhere to go and is finished from the start.
+func NewActorWithItinerary(startingFloor int, startingTick Tick, itinerary []Leg, options ...ActorOption) *Actor {
+	goal := startingFloor
+	state := Finished
+	if len(itinerary) > 0 {
+		goal = itinerary[0].Floor
+		state = Unstarted
+	}
+	a := &Actor{
 		floorGoal:         goal,
 		startingFloor:     startingFloor,
 		startingTick:      startingTick,
+		legs:              append([]Leg(nil), itinerary...),
+		calledTick:        -1,
+		boardedTick:       -1,
 		completedGoalTick: -1,
-		state:             Unstarted,
+		abandonedTick:     -1,
+		waitingSince:      -1,
+		legGoal:           goal,
+		state:             state,
+		actorID:           -1,
+		behavior:          DefaultBehavior{},
+		load:              DefaultActorLoad,
+	}
+	for _, option := range options {
+		option(a)
 	}
+	return a
 }
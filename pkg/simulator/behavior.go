@@ -0,0 +1,225 @@
+package simulator
+
+// ActorStatus is a point in time view of an actor for behaviors.
+type ActorStatus struct {
+	Entity EntityID
+	// Floor is the floor the actor is on, or the floor of the car they are riding.
+	Floor FloorID
+	// Goal is the floor of the current leg of the actor's itinerary.
+	Goal FloorID
+	// LegGoal is the floor the actor is riding to next, either Goal or a floor to transfer cars at.
+	LegGoal FloorID
+	// Load is how much of a car the actor takes up.
+	Load Load
+	// Elapsed is the number of ticks since the actor began waiting on their floor, or boarded the car they are riding.
+	Elapsed Tick
+	// Now is the tick being simulated.
+	Now Tick
+}
+
+// Behavior makes the choices of an actor as they travel between floors.  The simulation moves the actor and times their
+// journey while consulting the behavior at each decision.  A single behavior may be shared between actors.
+type Behavior interface {
+	// Walks decides if the actor takes the stairs to their goal rather than calling for an elevator.  The number of ticks
+	// the climb takes is returned when walking.
+	Walks(actor ActorStatus) (Tick, bool)
+	// Boards is true if the actor steps into the car.  The car has its doors open on the actor's floor and serves the
+	// floor the actor is riding to.  An actor passing over a car calls for another once the car departs.
+	Boards(actor ActorStatus, elevator ElevatorStatus) bool
+	// Entered reports if an actor stepping into the car made it in, as the car may turn them away once full.
+	Entered(actor ActorStatus, elevator ElevatorStatus, entered bool)
+	// PressesAgain is true if the actor riding the car presses the button for their floor again, informing the
+	// controller of the selection once more.
+	PressesAgain(actor ActorStatus, elevator ElevatorStatus) bool
+}
+
+// DefaultBehavior boards the first car able to take the actor towards their goal, selects their floor once and never
+// takes the stairs.
+type DefaultBehavior struct{}
+
+func (DefaultBehavior) Walks(actor ActorStatus) (Tick, bool) {
+	return 0, false
+}
+
+func (DefaultBehavior) Boards(actor ActorStatus, elevator ElevatorStatus) bool {
+	return true
+}
+
+func (DefaultBehavior) Entered(actor ActorStatus, elevator ElevatorStatus, entered bool) {}
+
+func (DefaultBehavior) PressesAgain(actor ActorStatus, elevator ElevatorStatus) bool {
+	return false
+}
+
+// GoingMyWay only boards cars heading in the actor's direction of travel.  Cars which have yet to be given a direction
+// are boarded.  All other decisions are left to the given behavior.
+func GoingMyWay(behavior Behavior) Behavior {
+	return &goingMyWay{Behavior: behavior}
+}
+
+type goingMyWay struct {
+	Behavior
+}
+
+func (g *goingMyWay) Boards(actor ActorStatus, elevator ElevatorStatus) bool {
+	heading := headingOf(elevator)
+	if heading != DirectionNone && heading != DirectionBetween(actor.Floor, actor.LegGoal) {
+		return false
+	}
+	return g.Behavior.Boards(actor, elevator)
+}
+
+// headingOf is the direction the car will travel once it departs, going by its pending move then the floors selected by
+// riders then the controller's itinerary.  DirectionNone is returned for cars with nowhere to go.
+func headingOf(elevator ElevatorStatus) Direction {
+	if elevator.Direction != DirectionNone {
+		return elevator.Direction
+	}
+	if elevator.Target != elevator.Floor {
+		return DirectionBetween(elevator.Floor, elevator.Target)
+	}
+	for _, floors := range [][]FloorID{elevator.CarCalls, elevator.Itinerary} {
+		for _, floor := range floors {
+			if floor != elevator.Floor {
+				return DirectionBetween(elevator.Floor, floor)
+			}
+		}
+	}
+	return DirectionNone
+}
+
+// Repressing presses the button for the actor's floor again every given number of ticks while riding.  All other
+// decisions are left to the given behavior.
+func Repressing(every Tick, behavior Behavior) Behavior {
+	return &repressing{Behavior: behavior, every: every}
+}
+
+type repressing struct {
+	Behavior
+	every Tick
+}
+
+func (r *repressing) PressesAgain(actor ActorStatus, elevator ElevatorStatus) bool {
+	if r.every > 0 && actor.Elapsed > 0 && actor.Elapsed%r.every == 0 {
+		return true
+	}
+	return r.Behavior.PressesAgain(actor, elevator)
+}
+
+// TakesStairs walks trips of up to the given number of floors, taking ticksPerFloor to climb each floor.  All other
+// decisions are left to the given behavior.
+func TakesStairs(maxFloors int, ticksPerFloor Tick, behavior Behavior) Behavior {
+	return &takesStairs{Behavior: behavior, maxFloors: maxFloors, ticksPerFloor: ticksPerFloor}
+}
+
+type takesStairs struct {
+	Behavior
+	maxFloors     int
+	ticksPerFloor Tick
+}
+
+func (s *takesStairs) Walks(actor ActorStatus) (Tick, bool) {
+	floors := abs(int(actor.Goal - actor.Floor))
+	if floors <= s.maxFloors {
+		return Tick(floors) * s.ticksPerFloor, true
+	}
+	return s.Behavior.Walks(actor)
+}
+
+// Group has actors travel together, sharing the returned behavior between the given number of members.  Members wait
+// until all have gathered on the same floor headed for the same floor then board the same car together once it has room
+// for all of them, by both head count and load.  Should the car leave or fill before every member has stepped in, the
+// members left behind travel on their own.  Members must share an itinerary.  All other decisions are left to the given
+// behavior.
+func Group(size int, behavior Behavior) Behavior {
+	return &group{
+		Behavior:   behavior,
+		size:       size,
+		waiting:    make(map[EntityID]ActorStatus),
+		committed:  make(map[EntityID]bool),
+		stragglers: make(map[EntityID]bool),
+	}
+}
+
+type group struct {
+	Behavior
+	size int
+	// waiting are the members last seen waiting on a floor who have yet to board.
+	waiting map[EntityID]ActorStatus
+	// committed are the gathered members yet to step into car, the car chosen for the group at tick committedAt.
+	committed   map[EntityID]bool
+	car         ElevatorID
+	committedAt Tick
+	// aboard counts the committed members who have stepped into the car.
+	aboard int
+	// stragglers are members left behind by the rest of the group, who board on their own.
+	stragglers map[EntityID]bool
+}
+
+func (g *group) Boards(actor ActorStatus, elevator ElevatorStatus) bool {
+	if g.stragglers[actor.Entity] {
+		return g.Behavior.Boards(actor, elevator)
+	}
+	g.waiting[actor.Entity] = actor
+	// Members act once a tick in turn, so members yet to step in by the tick after the car was chosen were left behind.
+	if len(g.committed) > 0 && actor.Now > g.committedAt+1 {
+		g.disband()
+		if g.stragglers[actor.Entity] {
+			return g.Behavior.Boards(actor, elevator)
+		}
+	}
+	if len(g.committed) == 0 {
+		// Members who already acted this tick would only step in on the next, by which time the doors may have closed,
+		// so the group sets off from the turn of whichever member first sees the car once everyone has gathered.
+		gathered, acted, load := 0, false, Load(0)
+		for id, member := range g.waiting {
+			if member.Floor == actor.Floor && member.LegGoal == actor.LegGoal {
+				gathered++
+				load += member.Load
+				acted = acted || (id != actor.Entity && member.Now == actor.Now)
+			}
+		}
+		if gathered < g.size || acted || elevator.Capacity-elevator.Riders < gathered ||
+			(elevator.RatedLoad > 0 && elevator.RatedLoad-elevator.Load < load) || !g.Behavior.Boards(actor, elevator) {
+			return false
+		}
+		for id, member := range g.waiting {
+			if member.Floor == actor.Floor && member.LegGoal == actor.LegGoal {
+				g.committed[id] = true
+			}
+		}
+		g.car = elevator.Elevator
+		g.committedAt = actor.Now
+		g.aboard = 0
+	}
+	return g.committed[actor.Entity] && elevator.Elevator == g.car
+}
+
+func (g *group) Entered(actor ActorStatus, elevator ElevatorStatus, entered bool) {
+	switch {
+	case g.stragglers[actor.Entity]:
+		if entered {
+			delete(g.stragglers, actor.Entity)
+		}
+	case !entered:
+		g.disband()
+	default:
+		delete(g.waiting, actor.Entity)
+		delete(g.committed, actor.Entity)
+		g.aboard++
+	}
+	g.Behavior.Entered(actor, elevator, entered)
+}
+
+// disband abandons the chosen car.  Members yet to step in are left to travel on their own when part of the group has
+// already departed, otherwise the group gathers again.
+func (g *group) disband() {
+	for id := range g.committed {
+		if g.aboard > 0 {
+			g.stragglers[id] = true
+			delete(g.waiting, id)
+		}
+		delete(g.committed, id)
+	}
+	g.aboard = 0
+}
@@ -0,0 +1,94 @@
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Building describes the floors of a simulation.  Floors are indexed from zero at the lowest level, including any
+// basements, with labels providing the names occupants know the floors by.
+type Building struct {
+	// Floors labels each floor from the lowest level upwards.
+	Floors []string `json:"floors"`
+	// Lobby is the ground floor of the building, where occupants enter from the street.
+	Lobby FloorID `json:"lobby"`
+}
+
+// NumberedBuilding provides a building with the given number of floors, labeled by their index, with the lowest floor
+// as the lobby.
+func NumberedBuilding(floors int) Building {
+	return Building{Floors: FloorRange(0, floors-1), Lobby: 0}
+}
+
+// FloorRange labels floors numbered from through to inclusive, for example FloorRange(1, 40) for the floors above a
+// lobby.
+func FloorRange(from int, to int) []string {
+	labels := make([]string, 0, to-from+1)
+	for level := from; level <= to; level++ {
+		labels = append(labels, strconv.Itoa(level))
+	}
+	return labels
+}
+
+// Basements labels the given number of basement floors from the deepest upwards, for example B2 and B1.
+func Basements(count int) []string {
+	labels := make([]string, 0, count)
+	for level := count; level > 0; level-- {
+		labels = append(labels, fmt.Sprintf("B%d", level))
+	}
+	return labels
+}
+
+// Label provides the name of the floor.  Floors without a label are named by their index.
+func (b Building) Label(floor FloorID) string {
+	if floor >= 0 && int(floor) < len(b.Floors) && b.Floors[floor] != "" {
+		return b.Floors[floor]
+	}
+	return strconv.Itoa(int(floor))
+}
+
+// Floor finds the floor with the given label.
+func (b Building) Floor(label string) (FloorID, bool) {
+	for i, l := range b.Floors {
+		if l == label {
+			return FloorID(i), true
+		}
+	}
+	return -1, false
+}
+
+// MustFloor finds the floor with the given label, panicking if no such floor exists.  Intended for scenario definitions.
+func (b Building) MustFloor(label string) FloorID {
+	floor, ok := b.Floor(label)
+	if !ok {
+		panic(fmt.Sprintf("building has no floor labeled %q", label))
+	}
+	return floor
+}
+
+// Level is the signed position of the floor relative to the lobby, negative for basements.
+func (b Building) Level(floor FloorID) int {
+	return int(floor - b.Lobby)
+}
+
+// Validate ensures every floor has a distinct label and the lobby is within the building.
+func (b Building) Validate() error {
+	if len(b.Floors) < 1 {
+		return errors.New("building must have at least one floor")
+	}
+	if b.Lobby < 0 || int(b.Lobby) >= len(b.Floors) {
+		return fmt.Errorf("lobby %d is outside of the building's %d floors", b.Lobby, len(b.Floors))
+	}
+	seen := make(map[string]bool, len(b.Floors))
+	for i, label := range b.Floors {
+		if label == "" {
+			return fmt.Errorf("floor %d is missing a label", i)
+		}
+		if seen[label] {
+			return fmt.Errorf("floor label %q is used more than once", label)
+		}
+		seen[label] = true
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package simulator
+
+import "fmt"
+
+// NoFloor is the Floor of a ControllerFaultError for commands which do not take a floor.
+const NoFloor FloorID = -1
+
+// NoElevator is the Elevator of a ControllerFaultError for faults which do not concern a single elevator, such as a
+// remote controller failing to respond.
+const NoElevator ElevatorID = -1
+
+// ControllerFaultError is produced when a controller issues a command the simulation is unable to carry out because it
+// references an elevator or floor which does not exist.  The error is returned by the command as well as being given to
+// a ControllerFaultObserver.  Queries of elevators which do not exist return the error
+// without it being held against the controller.
+type ControllerFaultError struct {
+	// Command names the offending command, such as MoveTo.
+	Command string `json:"command"`
+	// Elevator is the elevator given with the command, or NoElevator.
+	Elevator ElevatorID `json:"elevator"`
+	// Floor is the floor given with the command, or NoFloor.
+	Floor FloorID `json:"floor"`
+	// Reason describes what was wrong with the command.
+	Reason string `json:"reason"`
+}
+
+func (c *ControllerFaultError) Error() string {
+	switch {
+	case c.Elevator == NoElevator:
+		return fmt.Sprintf("%s: %s", c.Command, c.Reason)
+	case c.Floor == NoFloor:
+		return fmt.Sprintf("%s for elevator %d: %s", c.Command, c.Elevator, c.Reason)
+	default:
+		return fmt.Sprintf("%s for elevator %d to floor %d: %s", c.Command, c.Elevator, c.Floor, c.Reason)
+	}
+}
+
+// ControllerFaultObserver may optionally be implemented by a Controller to learn of commands it issued which were not
+// carried out.  The error is a *ControllerFaultError.
+type ControllerFaultObserver interface {
+	ControllerFault(err error)
+}
+
+// ControllerFaultReporter is implemented by the ControlledElevators of a Simulation for controllers which are able to
+// fail on their own, such as those bridging to another process.  Reported faults are handled under the
+// ControllerFaultPolicy as any other, although the controller is not notified of a fault it reported itself.
+type ControllerFaultReporter interface {
+	ReportControllerFault(err *ControllerFaultError)
+}
+
+// ControllerFaultPolicy decides the consequences for a controller issuing a command the simulation is unable to carry
+// out.  The offending command is never performed and is always reported through a ControllerFault event.
+type ControllerFaultPolicy int
+
+const (
+	// PenalizeControllerFaults counts each fault against the score of the controller.
+	PenalizeControllerFaults ControllerFaultPolicy = iota
+	// IgnoreControllerFaults drops the offending command without further consequence.
+	IgnoreControllerFaults
+	// DisqualifyControllerFaults disqualifies the controller on the first fault, ending the simulation.
+	DisqualifyControllerFaults
+)
+
+// SetControllerFaultPolicy decides the consequences of controller faults, with PenalizeControllerFaults being the
+// default.
+func (s *Simulation) SetControllerFaultPolicy(policy ControllerFaultPolicy) {
+	s.state.Lock()
+	defer s.state.Unlock()
+	s.controllerFaultPolicy = policy
+}
+
+// Disqualification is the fault which disqualified the controller, or nil while the controller remains in the running.
+func (s *Simulation) Disqualification() error {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	if s.disqualification == nil {
+		return nil
+	}
+	return s.disqualification
+}
+
+// checkCommand verifies the elevator and floors of a controller command exist, reporting and returning a controller
+// fault otherwise.  Commands issued by a disqualified controller are dropped, returning the fault which disqualified it.
+func (s *Simulation) checkCommand(command string, elevatorID ElevatorID, floors ...FloorID) error {
+	if s.disqualification != nil {
+		return s.disqualification
+	}
+	floor := NoFloor
+	if len(floors) > 0 {
+		floor = floors[0]
+	}
+	if err := s.unknownElevator(command, elevatorID); err != nil {
+		err.Floor = floor
+		s.controllerFault(err)
+		return err
+	}
+	for _, floor := range floors {
+		if floor < 0 || int(floor) >= len(s.floors) {
+			err := &ControllerFaultError{Command: command, Elevator: elevatorID, Floor: floor, Reason: "no such floor"}
+			s.controllerFault(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// unknownElevator produces the error for commands and queries referencing an elevator which does not exist, or nil
+// when the elevator exists.
+func (s *Simulation) unknownElevator(command string, elevatorID ElevatorID) *ControllerFaultError {
+	if elevatorID < 0 || int(elevatorID) >= len(s.elevators) {
+		return &ControllerFaultError{Command: command, Elevator: elevatorID, Floor: NoFloor, Reason: "no such elevator"}
+	}
+	return nil
+}
+
+// ReportControllerFault holds a fault the controller encountered on its own against it, such as a remote controller
+// failing to respond.
+func (s *Simulation) ReportControllerFault(err *ControllerFaultError) {
+	s.holdAgainstController(err)
+}
+
+// controllerFault reports the fault to listeners and the controller, applying the fault policy.
+func (s *Simulation) controllerFault(err *ControllerFaultError) {
+	s.holdAgainstController(err)
+	if observer, ok := s.controller.(ControllerFaultObserver); ok {
+		observer.ControllerFault(err)
+	}
+}
+
+// holdAgainstController reports the fault to listeners, applying the fault policy.
+func (s *Simulation) holdAgainstController(err *ControllerFaultError) {
+	s.logger.Debug("controller fault", "tick", s.tick, "command", err.Command, "elevator", err.Elevator, "floor", err.Floor, "reason", err.Reason)
+	s.dispatchControllerEvent(OnControllerFault(s.tick, err.Elevator, err.Floor))
+	switch s.controllerFaultPolicy {
+	case PenalizeControllerFaults:
+		s.controllerFaults++
+	case DisqualifyControllerFaults:
+		s.controllerFaults++
+		s.disqualification = err
+	}
+}
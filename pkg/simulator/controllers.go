@@ -2,12 +2,59 @@ package simulator
 
 type Controller interface {
 	Init(elevators []ElevatorID)
-	Called(floor FloorID)
+	// Called is invoked when an actor presses the hall call button for the given direction on a floor.
+	Called(floor FloorID, direction Direction)
 	FloorSelected(elevatorID ElevatorID, floor FloorID)
 	CompletedMove(elevatorID ElevatorID)
 }
 
+// ControlledElevators is provided to a Controller to observe and command the elevators.
 type ControlledElevators interface {
-	// MoveTo instructs the given elevator to go to the specified target floor.
-	MoveTo(elevatorID ElevatorID, floor FloorID)
+	ObservedElevators
+	// MoveTo instructs the given elevator to go to the specified target floor.  A moving elevator is redirected if it is
+	// able to stop at the floor, otherwise the move is deferred until the elevator completes its current stop.
+	//
+	// Each command returns a *ControllerFaultError when it references an elevator or floor which does not exist, and
+	// commands moving an elevator return the reason the move was rejected, such as an *OutOfServiceError.
+	MoveTo(elevatorID ElevatorID, floor FloorID) error
+
+	// EnqueueStops appends the floors to the elevator's itinerary.  The elevator visits each stop of its itinerary in
+	// order, cycling its doors at each, without waiting for further direction.
+	EnqueueStops(elevatorID ElevatorID, floors ...FloorID) error
+	// InsertStop places the floor at the given position of the elevator's itinerary, with zero being the next stop.
+	// Positions beyond the end of the itinerary append the stop.
+	InsertStop(elevatorID ElevatorID, position int, floor FloorID) error
+	// ClearStops discards the elevator's itinerary.  A car already travelling to a stop completes that run.
+	ClearStops(elevatorID ElevatorID) error
+	// Itinerary is the ordered list of stops the elevator has yet to make.  A *ControllerFaultError is returned for
+	// elevators which do not exist.
+	Itinerary(elevatorID ElevatorID) ([]FloorID, error)
+}
+
+// TickObserver may optionally be implemented by a Controller to be given control at the start of every tick, such as to
+// park idle elevators or run timers.  Tick is invoked before the elevators and actors for the tick are advanced.
+type TickObserver interface {
+	Tick(tick Tick)
+}
+
+// MoveDeferredObserver may optionally be implemented by a Controller to be informed when a MoveTo for a moving elevator
+// could not be honored because the elevator is unable to stop at the floor in time.  The elevator will travel to the
+// floor once it has completed its current stop unless the controller issues another move in the meantime.
+type MoveDeferredObserver interface {
+	MoveDeferred(elevatorID ElevatorID, floor FloorID)
+}
+
+// ServedFloors maps each elevator to the floors it is able to stop at.
+type ServedFloors map[ElevatorID][]FloorID
+
+// ZonedController may optionally be implemented by a Controller to learn which floors each elevator serves.  InitZones
+// is invoked immediately before Init.
+type ZonedController interface {
+	InitZones(served ServedFloors)
+}
+
+// BuildingController may optionally be implemented by a Controller to learn the floors of the building.  InitBuilding is
+// invoked before InitZones and Init.
+type BuildingController interface {
+	InitBuilding(building Building)
 }
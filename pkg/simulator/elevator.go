@@ -1,75 +1,358 @@
 package simulator
 
-import "fmt"
-
 const (
 	Idle = iota
 	MovingUp
 	MovingDown
+	DoorsOpening
+	DoorsOpen
+	DoorsClosing
 )
 
+// DefaultElevatorCapacity is the number of actors an elevator may carry when not otherwise configured.
+const DefaultElevatorCapacity = 5
+
+// Load is the weight or space an actor takes up within a car, in kilograms or whatever unit a scenario settles on.
+type Load int
+
+// DefaultActorLoad is the load of an actor when not otherwise configured, roughly a person in kilograms.
+const DefaultActorLoad Load = 75
+
+// ElevatorConfig describes the physical characteristics of a single elevator car.
+type ElevatorConfig struct {
+	// Capacity is the maximum number of actors which may ride the car at once.
+	Capacity int8
+	// RatedLoad is the maximum combined load of the riders, such as the rated load of a freight car.  Zero only limits
+	// the car by Capacity.  An actor with a load beyond the rated load is never able to board.
+	RatedLoad Load
+	// DoorsOpeningTicks is the number of ticks the doors take to open once the car has stopped at a floor.
+	DoorsOpeningTicks int
+	// DwellTicks is the number of ticks the doors are held open for actors to exit and board.  A dwell of less than a
+	// single tick is treated as a single tick so actors have an opportunity to board.
+	DwellTicks int
+	// DoorsClosingTicks is the number of ticks the doors take to close before the car may depart.
+	DoorsClosingTicks int
+
+	// TicksPerFloor is the number of ticks the car takes to travel a single floor at cruising speed.  Values less than a
+	// single tick are treated as a single tick.
+	TicksPerFloor int
+	// AccelerationTicks is the additional time spent on the first floor of a run while the car gets up to speed.
+	AccelerationTicks int
+	// DecelerationTicks is the additional time spent on the final floor of a run while the car slows to a stop.
+	DecelerationTicks int
+
+	// Energy describes how much energy the car consumes while operating.
+	Energy EnergyProfile
+
+	// ServedFloors restricts the floors the car stops at, such as for express cars or separate low-rise and high-rise
+	// banks.  An empty set serves every floor.  The car starts at the lowest floor it serves.
+	ServedFloors []FloorID
+}
+
+// DefaultElevatorConfig provides the configuration used for elevators when a scenario does not specify otherwise.
+func DefaultElevatorConfig() ElevatorConfig {
+	return ElevatorConfig{
+		Capacity:          DefaultElevatorCapacity,
+		DoorsOpeningTicks: 1,
+		DwellTicks:        1,
+		DoorsClosingTicks: 1,
+		TicksPerFloor:     1,
+		Energy:            DefaultEnergyProfile(),
+	}
+}
+
 type Elevator struct {
 	state       int
 	moveToFloor int
 
+	config       ElevatorConfig
 	capacity     int8
 	currentFloor int
-	//TODO: Probably better as event stream for controller
+	// desiredFloors are the floors selected by riders which the car has yet to stop at.
 	desiredFloors []int
+
+	// phaseTicks is the number of ticks remaining in the current door phase.
+	phaseTicks int
+	// travelTicks is the number of ticks remaining until a moving car reaches the next floor.
+	travelTicks int
+	// energy is the cumulative energy consumed by the car.
+	energy Energy
+	// pendingMove is true when a move was requested while the doors were cycling.  The car departs for pendingFloor once
+	// the doors have closed.
+	pendingMove  bool
+	pendingFloor int
+	// fault is the reason the car is out of service, freezing it in its current state, or FaultNone while in service.
+	fault FaultKind
+	// itinerary are the stops the car makes in order once it has no other move to perform.
+	itinerary []int
 }
 
 func NewElevator(capacity int8) *Elevator {
+	config := DefaultElevatorConfig()
+	config.Capacity = capacity
+	return NewConfiguredElevator(config)
+}
+
+// NewConfiguredElevator creates an idle elevator on the ground floor with the given characteristics.
+func NewConfiguredElevator(config ElevatorConfig) *Elevator {
+	if config.DwellTicks < 1 {
+		config.DwellTicks = 1
+	}
+	if config.TicksPerFloor < 1 {
+		config.TicksPerFloor = 1
+	}
+	startingFloor := 0
+	for i, floor := range config.ServedFloors {
+		if i == 0 || int(floor) < startingFloor {
+			startingFloor = int(floor)
+		}
+	}
 	return &Elevator{
 		state:         Idle,
-		capacity:      capacity,
-		currentFloor:  0,
+		config:        config,
+		capacity:      config.Capacity,
+		currentFloor:  startingFloor,
 		desiredFloors: make([]int, 0),
 	}
 }
 
+// serves is true if the car is able to stop at the floor.
+func (e *Elevator) serves(floor int) bool {
+	if len(e.config.ServedFloors) == 0 {
+		return true
+	}
+	for _, served := range e.config.ServedFloors {
+		if int(served) == floor {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Elevator) Tick(s *Simulation, id int, tick Tick) {
+	if e.fault != FaultNone {
+		return
+	}
 	switch e.state {
 	case MovingUp:
-		e.currentFloor++
-		s.elevatorOnFloor(ElevatorID(id), FloorID(e.currentFloor))
-		e.maybeDoneMoving(s, id)
+		e.travel(s, id, 1)
 	case MovingDown:
-		e.currentFloor--
-		s.elevatorOnFloor(ElevatorID(id), FloorID(e.currentFloor))
-		e.maybeDoneMoving(s, id)
+		e.travel(s, id, -1)
+	case DoorsOpening:
+		e.phaseTicks--
+		if e.phaseTicks <= 0 {
+			e.openDoors(s, id)
+		}
+	case DoorsOpen:
+		e.phaseTicks--
+		if e.phaseTicks <= 0 {
+			e.startClosingDoors(s, id)
+		}
+	case DoorsClosing:
+		e.phaseTicks--
+		if e.phaseTicks <= 0 {
+			e.closeDoors(s, id)
+		}
+	}
+}
+
+// travel advances a moving car towards the next floor in the given direction, arriving once the travel time for the
+// floor has elapsed.
+func (e *Elevator) travel(s *Simulation, id int, direction int) {
+	e.travelTicks--
+	if e.travelTicks > 0 {
+		return
+	}
+	e.currentFloor += direction
+	e.energy += e.config.Energy.floorCost(direction, s.loadIn(id))
+	s.elevatorOnFloor(ElevatorID(id), FloorID(e.currentFloor))
+	e.maybeDoneMoving(s, id)
+	if e.state == MovingUp || e.state == MovingDown {
+		e.travelTicks = e.floorTravelTicks(false)
+	}
+}
+
+// floorTravelTicks is the time required to travel the next floor of the current run.  The first floor of a run carries
+// the acceleration penalty while the final floor carries the deceleration penalty.
+func (e *Elevator) floorTravelTicks(starting bool) int {
+	ticks := e.config.TicksPerFloor
+	if starting {
+		ticks += e.config.AccelerationTicks
+	}
+	remaining := e.moveToFloor - e.currentFloor
+	if remaining == 1 || remaining == -1 {
+		ticks += e.config.DecelerationTicks
 	}
+	return ticks
 }
 
 func (e *Elevator) maybeDoneMoving(s *Simulation, id int) {
 	if e.currentFloor == e.moveToFloor {
-		fmt.Printf("Elevator{id: %d} -- Finished moving to floor %d\n", id, e.currentFloor)
-		e.state = Idle
+		if e.state == MovingUp || e.state == MovingDown {
+			e.energy += e.config.Energy.StartStop
+		}
 		s.elevatorDoneMoving(ElevatorID(id))
+		if len(e.itinerary) > 0 && e.itinerary[0] == e.currentFloor {
+			e.itinerary = e.itinerary[1:]
+			s.elevatorServedStop(ElevatorID(id))
+		}
+		e.startOpeningDoors(s, id)
+	}
+}
+
+func (e *Elevator) startOpeningDoors(s *Simulation, id int) {
+	e.state = DoorsOpening
+	e.phaseTicks = e.config.DoorsOpeningTicks
+	if e.phaseTicks <= 0 {
+		e.openDoors(s, id)
+	}
+}
+
+func (e *Elevator) openDoors(s *Simulation, id int) {
+	e.state = DoorsOpen
+	e.phaseTicks = e.config.DwellTicks
+	s.elevatorDoorsOpened(ElevatorID(id))
+}
+
+func (e *Elevator) startClosingDoors(s *Simulation, id int) {
+	e.state = DoorsClosing
+	e.phaseTicks = e.config.DoorsClosingTicks
+	if e.phaseTicks <= 0 {
+		e.closeDoors(s, id)
+	}
+}
+
+// closeDoors completes the door cycle.  The controller is informed the move has completed before any move requested
+// during the door cycle is started, allowing the controller to supersede it.
+func (e *Elevator) closeDoors(s *Simulation, id int) {
+	e.state = Idle
+	s.elevatorDoorsClosed(ElevatorID(id))
+	if e.pendingMove {
+		e.pendingMove = false
+		e.moveTo(s, id, e.pendingFloor)
 	}
+	e.followItinerary(s, id)
 }
 
 func (e *Elevator) moveTo(s *Simulation, id int, floor int) {
+	switch e.state {
+	case DoorsOpening, DoorsOpen:
+		e.pendingMove = floor != e.currentFloor
+		e.pendingFloor = floor
+		return
+	case DoorsClosing:
+		if floor == e.currentFloor {
+			e.pendingMove = false
+			e.startOpeningDoors(s, id)
+		} else {
+			e.pendingMove = true
+			e.pendingFloor = floor
+		}
+		return
+	}
 	distance := floor - e.currentFloor
 	e.move(s, id, distance)
 }
 
 func (e *Elevator) move(s *Simulation, id int, floors int) {
 	switch e.state {
+	case MovingUp, MovingDown:
+		e.redirect(s, id, e.currentFloor+floors)
 	case Idle:
+		e.pendingMove = false
 		e.moveToFloor = e.currentFloor + floors
 		if floors > 0 {
 			e.state = MovingUp
+			e.travelTicks = e.floorTravelTicks(true)
+			e.energy += e.config.Energy.StartStop
 		} else if floors < 0 {
 			e.state = MovingDown
+			e.travelTicks = e.floorTravelTicks(true)
+			e.energy += e.config.Energy.StartStop
 		} else {
 			e.maybeDoneMoving(s, id)
 		}
 	}
 }
 
+// redirect retargets a moving car.  A car may only be redirected to a floor in its direction of travel it is still able
+// to stop at.  Otherwise the move is deferred until the car has completed its current stop.
+func (e *Elevator) redirect(s *Simulation, id int, floor int) {
+	direction := e.travelDirection()
+	if (floor-e.stoppingFloor())*direction < 0 {
+		e.pendingMove = true
+		e.pendingFloor = floor
+		s.elevatorMoveDeferred(ElevatorID(id), FloorID(floor))
+		return
+	}
+	e.pendingMove = false
+	nextFloor := e.currentFloor + direction
+	if e.moveToFloor == nextFloor && floor != nextFloor {
+		// the car no longer needs to slow down for the next floor
+		e.travelTicks -= e.config.DecelerationTicks
+		if e.travelTicks < 1 {
+			e.travelTicks = 1
+		}
+	}
+	e.moveToFloor = floor
+}
+
+// travelDirection is 1 when the car is moving up, -1 when the car is moving down, and 0 otherwise.
+func (e *Elevator) travelDirection() int {
+	switch e.state {
+	case MovingUp:
+		return 1
+	case MovingDown:
+		return -1
+	}
+	return 0
+}
+
+// stoppingFloor is the nearest floor a moving car is able to stop at.  A car is able to stop at the floor it is
+// approaching if it is already slowing down for that floor or requires no time to decelerate.  Otherwise the car must
+// continue on to the following floor.
+func (e *Elevator) stoppingFloor() int {
+	direction := e.travelDirection()
+	nextFloor := e.currentFloor + direction
+	if e.moveToFloor == nextFloor || e.config.DecelerationTicks == 0 {
+		return nextFloor
+	}
+	return nextFloor + direction
+}
+
+// admits is true if the car rated for its load is able to take on the load in addition to what it is carrying.
+func (e *Elevator) admits(carrying Load, load Load) bool {
+	return e.config.RatedLoad <= 0 || carrying+load <= e.config.RatedLoad
+}
+
+// selectFloor registers a car call for the floor unless one is already pending.
+func (e *Elevator) selectFloor(floor int) {
+	for _, desired := range e.desiredFloors {
+		if desired == floor {
+			return
+		}
+	}
+	e.desiredFloors = append(e.desiredFloors, floor)
+}
+
+// answerCarCall clears the car call for the floor once the doors have opened there.
+func (e *Elevator) answerCarCall(floor int) {
+	remaining := e.desiredFloors[:0]
+	for _, desired := range e.desiredFloors {
+		if desired != floor {
+			remaining = append(remaining, desired)
+		}
+	}
+	e.desiredFloors = remaining
+}
+
+// isAtFloor is true when the elevator is stopped at the given floor with the doors open.
 func (e *Elevator) isAtFloor(s *Simulation, floor FloorID) bool {
+	if e.fault != FaultNone {
+		return false
+	}
 	switch e.state {
-	case Idle:
+	case DoorsOpen:
 		return e.currentFloor == int(floor)
 	}
 	return false
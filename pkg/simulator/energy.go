@@ -0,0 +1,75 @@
+package simulator
+
+// Energy is an abstract unit of energy consumed by elevators.
+type Energy float64
+
+// EnergyProfile describes the energy an elevator consumes while moving.  Each floor travelled costs the base amount for
+// the direction of travel plus the per rider amount for each DefaultActorLoad of load within the car, so freight
+// weighing as much as several people costs as much as carrying them.  Per rider amounts may be negative to model a
+// counterweighted car requiring less energy to lower a load, however travel never yields energy.
+type EnergyProfile struct {
+	// PerFloorUp is the base energy consumed travelling up a single floor.
+	PerFloorUp Energy
+	// PerFloorDown is the base energy consumed travelling down a single floor.
+	PerFloorDown Energy
+	// PerRiderFloorUp is the additional energy consumed for each DefaultActorLoad of load when travelling up a single
+	// floor.
+	PerRiderFloorUp Energy
+	// PerRiderFloorDown is the additional energy consumed for each DefaultActorLoad of load when travelling down a single
+	// floor.
+	PerRiderFloorDown Energy
+	// StartStop is the surcharge for each time the car starts a run and each time the car stops.
+	StartStop Energy
+}
+
+// DefaultEnergyProfile provides the energy model used for elevators when a scenario does not specify otherwise.
+func DefaultEnergyProfile() EnergyProfile {
+	return EnergyProfile{
+		PerFloorUp:        1,
+		PerFloorDown:      0.5,
+		PerRiderFloorUp:   0.2,
+		PerRiderFloorDown: -0.1,
+		StartStop:         2,
+	}
+}
+
+// floorCost is the energy consumed travelling a single floor in the given direction carrying the given load.
+func (p EnergyProfile) floorCost(direction int, load Load) Energy {
+	riders := Energy(load) / Energy(DefaultActorLoad)
+	var cost Energy
+	if direction > 0 {
+		cost = p.PerFloorUp + p.PerRiderFloorUp*riders
+	} else {
+		cost = p.PerFloorDown + p.PerRiderFloorDown*riders
+	}
+	if cost < 0 {
+		return 0
+	}
+	return cost
+}
+
+// ElevatorEnergy is the cumulative energy consumed by the given elevator.  A *ControllerFaultError is returned for
+// elevators which do not exist.
+func (s *Simulation) ElevatorEnergy(elevatorID ElevatorID) (Energy, error) {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	if err := s.unknownElevator("ElevatorEnergy", elevatorID); err != nil {
+		return 0, err
+	}
+	return s.elevators[elevatorID].energy, nil
+}
+
+// Energy is the cumulative energy consumed by all elevators within the simulation.
+func (s *Simulation) Energy() Energy {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	return s.energy()
+}
+
+func (s *Simulation) energy() Energy {
+	var total Energy
+	for _, e := range s.elevators {
+		total += e.energy
+	}
+	return total
+}
@@ -23,8 +23,84 @@ const (
 	ActorFinished
 
 	ElevatorAtFloor
+
+	ActorBoardingRejected
+
+	DoorsOpened
+	DoorsClosed
+
+	ElevatorMoveDeferred
+
+	ActorAbandoned
+
+	ElevatorMoveRejected
+
+	ElevatorOutOfService
+	ElevatorRestored
+
+	ElevatorStopServed
+
+	ActorSpawned
+	ActorBoarded
+	ActorExited
+
+	ActorTookStairs
+
+	ControllerFault
 )
 
+var eventTypeNames = [...]string{
+	TickStart:             "TickStart",
+	TickDone:              "TickDone",
+	InitStart:             "InitStart",
+	InformElevator:        "InformElevator",
+	InformFloor:           "InformFloor",
+	InitDone:              "InitDone",
+	ElevatorCalled:        "ElevatorCalled",
+	ElevatorArrived:       "ElevatorArrived",
+	ElevatorFloorRequest:  "ElevatorFloorRequest",
+	ActorFinished:         "ActorFinished",
+	ElevatorAtFloor:       "ElevatorAtFloor",
+	ActorBoardingRejected: "ActorBoardingRejected",
+	DoorsOpened:           "DoorsOpened",
+	DoorsClosed:           "DoorsClosed",
+	ElevatorMoveDeferred:  "ElevatorMoveDeferred",
+	ActorAbandoned:        "ActorAbandoned",
+	ElevatorMoveRejected:  "ElevatorMoveRejected",
+	ElevatorOutOfService:  "ElevatorOutOfService",
+	ElevatorRestored:      "ElevatorRestored",
+	ElevatorStopServed:    "ElevatorStopServed",
+	ActorSpawned:          "ActorSpawned",
+	ActorBoarded:          "ActorBoarded",
+	ActorExited:           "ActorExited",
+	ActorTookStairs:       "ActorTookStairs",
+	ControllerFault:       "ControllerFault",
+}
+
+// String is the name of the event type, such as ElevatorCalled.
+func (t EventType) String() string {
+	if t >= 0 && int(t) < len(eventTypeNames) {
+		return eventTypeNames[t]
+	}
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+// ParseEventType finds the event type with the given name.
+func ParseEventType(name string) (EventType, bool) {
+	for t, candidate := range eventTypeNames {
+		if candidate == name {
+			return EventType(t), true
+		}
+	}
+	return -1, false
+}
+
+// NoEntity is the Entity of events which do not concern an actor.
+const NoEntity EntityID = -1
+
+// Event describes something which occurred within the simulation.  Every event is stamped with the tick it occurred
+// during, or the tick about to be simulated for events occurring between ticks such as initialization.  Entity is the
+// actor the event concerns, or NoEntity.
 type Event struct {
 	EventType EventType
 	Timestamp Tick
@@ -33,6 +109,32 @@ type Event struct {
 	Elevator ElevatorID
 	Floor    FloorID
 	Points   int
+	// Direction is the requested direction of travel for ElevatorCalled events.
+	Direction Direction
+	// Fault is the reason an elevator was taken out of service for ElevatorOutOfService events.
+	Fault FaultKind
+	// FloorLabel is the building's name for the Floor of events concerning a floor.
+	FloorLabel string
+}
+
+// hasFloor is true for events which concern a specific floor.
+func (t EventType) hasFloor() bool {
+	switch t {
+	case InformFloor, ElevatorCalled, ElevatorArrived, ElevatorFloorRequest, ElevatorAtFloor, ActorBoardingRejected,
+		DoorsOpened, DoorsClosed, ElevatorMoveDeferred, ActorAbandoned, ElevatorMoveRejected, ElevatorOutOfService,
+		ElevatorRestored, ElevatorStopServed, ActorFinished, ActorSpawned, ActorBoarded, ActorExited, ActorTookStairs:
+		return true
+	default:
+		return false
+	}
+}
+
+// floorName is the label of the event's floor, falling back to the index when unlabeled.
+func (e Event) floorName() string {
+	if e.FloorLabel != "" {
+		return e.FloorLabel
+	}
+	return fmt.Sprintf("%d", e.Floor)
 }
 
 type ControllerListener interface {
@@ -46,23 +148,58 @@ func (e Event) ToString() string {
 	case TickDone:
 		return fmt.Sprintf("Event{TickDone,%d}", e.Timestamp)
 	case InitStart:
-		return fmt.Sprintf("Event{InitStart}")
+		return fmt.Sprintf("Event{InitStart,%d}", e.Timestamp)
 	case InitDone:
-		return fmt.Sprintf("Event{InitDone}")
+		return fmt.Sprintf("Event{InitDone,%d}", e.Timestamp)
 	case InformElevator:
 		return fmt.Sprintf("Event{InformElevator, %d}", e.Elevator)
 	case InformFloor:
-		return fmt.Sprintf("Event{InformFloor, %d}", e.Floor)
+		return fmt.Sprintf("Event{InformFloor, %s}", e.floorName())
 	case ElevatorCalled:
-		return fmt.Sprintf("Event{ElevatorCall, %d}", e.Floor)
+		return fmt.Sprintf("Event{ElevatorCall, %s going %s by actor %d}", e.floorName(), e.Direction, e.Entity)
 	case ElevatorArrived:
-		return fmt.Sprintf("Event{ElevatorArrived, %d @ %d}", e.Elevator, e.Floor)
+		return fmt.Sprintf("Event{ElevatorArrived, %d @ %s with actor %d}", e.Elevator, e.floorName(), e.Entity)
 	case ElevatorFloorRequest:
-		return fmt.Sprintf("Event{ElevatorFloorRequest, %d @ %d}", e.Elevator, e.Floor)
+		return fmt.Sprintf("Event{ElevatorFloorRequest, %d @ %s by actor %d}", e.Elevator, e.floorName(), e.Entity)
 	case ActorFinished:
-		return fmt.Sprintf("Event{ActorFinished, point: %d}", e.Points)
+		return fmt.Sprintf("Event{ActorFinished, actor %d @ floor %s, point: %d}", e.Entity, e.floorName(), e.Points)
 	case ElevatorAtFloor:
-		return fmt.Sprintf("Event{ElevatorAtFloor, elevator %d @ floor %d}", e.Elevator, e.Floor)
+		return fmt.Sprintf("Event{ElevatorAtFloor, elevator %d @ floor %s}", e.Elevator, e.floorName())
+	case ActorBoardingRejected:
+		return fmt.Sprintf("Event{ActorBoardingRejected, actor %d by elevator %d @ floor %s}", e.Entity, e.Elevator, e.floorName())
+	case DoorsOpened:
+		return fmt.Sprintf("Event{DoorsOpened, elevator %d @ floor %s}", e.Elevator, e.floorName())
+	case DoorsClosed:
+		return fmt.Sprintf("Event{DoorsClosed, elevator %d @ floor %s}", e.Elevator, e.floorName())
+	case ElevatorMoveDeferred:
+		return fmt.Sprintf("Event{ElevatorMoveDeferred, elevator %d to floor %s}", e.Elevator, e.floorName())
+	case ActorAbandoned:
+		return fmt.Sprintf("Event{ActorAbandoned, actor %d @ floor %s}", e.Entity, e.floorName())
+	case ElevatorMoveRejected:
+		return fmt.Sprintf("Event{ElevatorMoveRejected, elevator %d to floor %s}", e.Elevator, e.floorName())
+	case ElevatorOutOfService:
+		return fmt.Sprintf("Event{ElevatorOutOfService, elevator %d @ floor %s due to %s}", e.Elevator, e.floorName(), e.Fault)
+	case ElevatorRestored:
+		return fmt.Sprintf("Event{ElevatorRestored, elevator %d @ floor %s}", e.Elevator, e.floorName())
+	case ElevatorStopServed:
+		return fmt.Sprintf("Event{ElevatorStopServed, elevator %d @ floor %s}", e.Elevator, e.floorName())
+	case ActorSpawned:
+		return fmt.Sprintf("Event{ActorSpawned, actor %d @ floor %s}", e.Entity, e.floorName())
+	case ActorBoarded:
+		return fmt.Sprintf("Event{ActorBoarded, actor %d into elevator %d @ floor %s}", e.Entity, e.Elevator, e.floorName())
+	case ActorExited:
+		return fmt.Sprintf("Event{ActorExited, actor %d from elevator %d @ floor %s}", e.Entity, e.Elevator, e.floorName())
+	case ActorTookStairs:
+		return fmt.Sprintf("Event{ActorTookStairs, actor %d to floor %s}", e.Entity, e.floorName())
+	case ControllerFault:
+		switch {
+		case e.Elevator == NoElevator:
+			return "Event{ControllerFault}"
+		case e.Floor == NoFloor:
+			return fmt.Sprintf("Event{ControllerFault, elevator %d}", e.Elevator)
+		default:
+			return fmt.Sprintf("Event{ControllerFault, elevator %d to floor %d}", e.Elevator, e.Floor)
+		}
 	default:
 		return fmt.Sprintf("Unkonwn event type %d: %#v", e.EventType, e)
 	}
@@ -72,6 +209,7 @@ func OnTickStart(tick Tick) Event {
 	return Event{
 		EventType: TickStart,
 		Timestamp: tick,
+		Entity:    NoEntity,
 	}
 }
 
@@ -79,69 +217,229 @@ func OnTickDone(tick Tick) Event {
 	return Event{
 		EventType: TickDone,
 		Timestamp: tick,
+		Entity:    NoEntity,
 	}
 }
 
-func OnInitStart() Event {
+func OnInitStart(tick Tick) Event {
 	return Event{
 		EventType: InitStart,
-		Timestamp: -1,
+		Timestamp: tick,
+		Entity:    NoEntity,
 	}
 }
 
-func OnInitDone() Event {
+func OnInitDone(tick Tick) Event {
 	return Event{
 		EventType: InitDone,
-		Timestamp: -1,
+		Timestamp: tick,
+		Entity:    NoEntity,
 	}
 }
 
-func OnInformElevator(id ElevatorID) Event {
+func OnInformElevator(tick Tick, id ElevatorID) Event {
 	return Event{
 		EventType: InformElevator,
-		Timestamp: -1,
+		Timestamp: tick,
+		Entity:    NoEntity,
 		Elevator:  id,
 	}
 }
 
-func OnInformFloor(id FloorID) Event {
+func OnInformFloor(tick Tick, id FloorID) Event {
 	return Event{
 		EventType: InformFloor,
-		Timestamp: -1,
+		Timestamp: tick,
+		Entity:    NoEntity,
 		Floor:     id,
 	}
 }
 
-func OnElevatorArrived(tick Tick, elevator ElevatorID, floor FloorID) Event {
+func OnElevatorArrived(tick Tick, rider EntityID, elevator ElevatorID, floor FloorID) Event {
 	return Event{
 		EventType: ElevatorArrived,
 		Timestamp: tick,
+		Entity:    rider,
 		Elevator:  elevator,
 		Floor:     floor,
 	}
 }
 
-func OnElevatorCalled(tick Tick, floor FloorID) Event {
+func OnElevatorCalled(tick Tick, actor EntityID, floor FloorID, direction Direction) Event {
 	return Event{
 		EventType: ElevatorCalled,
 		Timestamp: tick,
+		Entity:    actor,
 		Floor:     floor,
+		Direction: direction,
 	}
 }
 
-func OnElevatorFloorRequest(tick Tick, elevator ElevatorID, floor FloorID) Event {
+func OnElevatorFloorRequest(tick Tick, actor EntityID, elevator ElevatorID, floor FloorID) Event {
 	return Event{
 		EventType: ElevatorFloorRequest,
 		Timestamp: tick,
+		Entity:    actor,
 		Elevator:  elevator,
 		Floor:     floor,
 	}
 }
 
-func OnActorFinished(tick Tick, points int) Event {
+func OnActorFinished(tick Tick, actor EntityID, floor FloorID, points int) Event {
 	return Event{
 		EventType: ActorFinished,
 		Timestamp: tick,
+		Entity:    actor,
+		Floor:     floor,
 		Points:    points,
 	}
 }
+
+func OnActorBoardingRejected(tick Tick, actor EntityID, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ActorBoardingRejected,
+		Timestamp: tick,
+		Entity:    actor,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnDoorsOpened(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: DoorsOpened,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnDoorsClosed(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: DoorsClosed,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnElevatorMoveDeferred(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ElevatorMoveDeferred,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnActorAbandoned(tick Tick, actor EntityID, floor FloorID) Event {
+	return Event{
+		EventType: ActorAbandoned,
+		Timestamp: tick,
+		Entity:    actor,
+		Floor:     floor,
+	}
+}
+
+func OnElevatorMoveRejected(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ElevatorMoveRejected,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnElevatorOutOfService(tick Tick, elevator ElevatorID, floor FloorID, fault FaultKind) Event {
+	return Event{
+		EventType: ElevatorOutOfService,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+		Fault:     fault,
+	}
+}
+
+func OnElevatorRestored(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ElevatorRestored,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnElevatorStopServed(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ElevatorStopServed,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnElevatorAtFloor(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ElevatorAtFloor,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnActorSpawned(tick Tick, actor EntityID, floor FloorID) Event {
+	return Event{
+		EventType: ActorSpawned,
+		Timestamp: tick,
+		Entity:    actor,
+		Floor:     floor,
+	}
+}
+
+func OnActorBoarded(tick Tick, actor EntityID, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ActorBoarded,
+		Timestamp: tick,
+		Entity:    actor,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnActorExited(tick Tick, actor EntityID, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ActorExited,
+		Timestamp: tick,
+		Entity:    actor,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
+
+func OnActorTookStairs(tick Tick, actor EntityID, floor FloorID) Event {
+	return Event{
+		EventType: ActorTookStairs,
+		Timestamp: tick,
+		Entity:    actor,
+		Floor:     floor,
+	}
+}
+
+// OnControllerFault carries the elevator and floor as given by the controller, which may not exist.
+func OnControllerFault(tick Tick, elevator ElevatorID, floor FloorID) Event {
+	return Event{
+		EventType: ControllerFault,
+		Timestamp: tick,
+		Entity:    NoEntity,
+		Elevator:  elevator,
+		Floor:     floor,
+	}
+}
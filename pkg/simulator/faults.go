@@ -0,0 +1,173 @@
+package simulator
+
+import "fmt"
+
+// FaultKind describes why an elevator has been taken out of service.
+type FaultKind int
+
+const (
+	FaultNone FaultKind = iota
+	// FaultBreakdown is a mechanical failure, stopping the car wherever it is.
+	FaultBreakdown
+	// FaultDoors is a failure of the doors, holding them in their current position.
+	FaultDoors
+	// FaultMaintenance is a planned service window.
+	FaultMaintenance
+)
+
+func (f FaultKind) String() string {
+	switch f {
+	case FaultBreakdown:
+		return "breakdown"
+	case FaultDoors:
+		return "doors"
+	case FaultMaintenance:
+		return "maintenance"
+	default:
+		return "none"
+	}
+}
+
+// ParseFaultKind finds the fault with the given name.
+func ParseFaultKind(name string) (FaultKind, bool) {
+	for _, f := range []FaultKind{FaultNone, FaultBreakdown, FaultDoors, FaultMaintenance} {
+		if f.String() == name {
+			return f, true
+		}
+	}
+	return FaultNone, false
+}
+
+// Fault schedules an elevator to be taken out of service.  The car is frozen in place for the duration of the fault:
+// riders are unable to exit until the car is restored and no actors may board.
+type Fault struct {
+	Elevator ElevatorID `json:"elevator"`
+	Kind     FaultKind  `json:"kind"`
+	// At is the tick the elevator is taken out of service.
+	At Tick `json:"at"`
+	// Duration is the number of ticks until the elevator is restored.  Zero leaves the elevator out of service for the
+	// remainder of the simulation.
+	Duration Tick `json:"duration"`
+}
+
+// OutOfServiceError is produced when a controller attempts to move an elevator which is out of service.
+type OutOfServiceError struct {
+	Elevator ElevatorID
+	Fault    FaultKind
+}
+
+func (o *OutOfServiceError) Error() string {
+	return fmt.Sprintf("elevator %d is out of service (%s)", o.Elevator, o.Fault)
+}
+
+// UnservedFloorError is produced when a controller attempts to move an elevator to a floor it does not serve.
+type UnservedFloorError struct {
+	Elevator ElevatorID
+	Floor    FloorID
+}
+
+func (u *UnservedFloorError) Error() string {
+	return fmt.Sprintf("elevator %d does not serve floor %d", u.Elevator, u.Floor)
+}
+
+// FaultObserver may optionally be implemented by a Controller to be informed as elevators are taken out of and returned
+// to service.
+type FaultObserver interface {
+	OutOfService(elevatorID ElevatorID, fault FaultKind)
+	Restored(elevatorID ElevatorID)
+}
+
+// MoveRejectedObserver may optionally be implemented by a Controller to learn why a MoveTo was not performed.  The error
+// is an *OutOfServiceError or *UnservedFloorError.
+type MoveRejectedObserver interface {
+	MoveRejected(elevatorID ElevatorID, floor FloorID, err error)
+}
+
+// ScheduleFault arranges for an elevator to be taken out of service during the simulation.  The simulation must be
+// initialized so the elevator is known.
+func (s *Simulation) ScheduleFault(fault Fault) error {
+	s.state.Lock()
+	defer s.state.Unlock()
+	if fault.Elevator < 0 || int(fault.Elevator) >= len(s.elevators) {
+		return fmt.Errorf("unable to schedule %s fault: no such elevator %d", fault.Kind, fault.Elevator)
+	}
+	s.faults = append(s.faults, fault)
+	return nil
+}
+
+// applyFaults takes elevators out of service and restores them as scheduled for the tick.  An elevator is only restored
+// once every fault on it has ended.
+func (s *Simulation) applyFaults(tick Tick) {
+	for _, fault := range s.faults {
+		if fault.Duration > 0 && fault.At+fault.Duration == tick && !s.faulted(fault.Elevator, tick) {
+			s.restoreElevator(fault.Elevator)
+		}
+	}
+	for _, fault := range s.faults {
+		if fault.At == tick {
+			s.disableElevator(fault.Elevator, fault.Kind)
+		}
+	}
+}
+
+// faulted is true while a fault scheduled before the tick continues to hold the elevator out of service.
+func (s *Simulation) faulted(elevatorID ElevatorID, tick Tick) bool {
+	for _, fault := range s.faults {
+		if fault.Elevator == elevatorID && fault.At < tick && (fault.Duration == 0 || fault.At+fault.Duration > tick) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Simulation) disableElevator(elevatorID ElevatorID, kind FaultKind) {
+	elevator := s.elevators[elevatorID]
+	if elevator.fault != FaultNone {
+		return
+	}
+	elevator.fault = kind
+	s.logger.Debug("elevator out of service", "tick", s.tick, "elevator", elevatorID, "fault", kind)
+	s.dispatchControllerEvent(OnElevatorOutOfService(s.tick, elevatorID, FloorID(elevator.currentFloor), kind))
+	if observer, ok := s.controller.(FaultObserver); ok {
+		observer.OutOfService(elevatorID, kind)
+	}
+}
+
+func (s *Simulation) restoreElevator(elevatorID ElevatorID) {
+	elevator := s.elevators[elevatorID]
+	if elevator.fault == FaultNone {
+		return
+	}
+	elevator.fault = FaultNone
+	s.logger.Debug("elevator restored", "tick", s.tick, "elevator", elevatorID)
+	s.dispatchControllerEvent(OnElevatorRestored(s.tick, elevatorID, FloorID(elevator.currentFloor)))
+	if observer, ok := s.controller.(FaultObserver); ok {
+		observer.Restored(elevatorID)
+	}
+	elevator.followItinerary(s, int(elevatorID))
+}
+
+// acceptMove verifies the elevator is able to travel to the floor, rejecting the move and returning the reason otherwise.
+func (s *Simulation) acceptMove(elevatorID ElevatorID, floor FloorID) error {
+	elevator := s.elevators[elevatorID]
+	var err error
+	switch {
+	case elevator.fault != FaultNone:
+		err = &OutOfServiceError{Elevator: elevatorID, Fault: elevator.fault}
+	case !elevator.serves(int(floor)):
+		err = &UnservedFloorError{Elevator: elevatorID, Floor: floor}
+	default:
+		return nil
+	}
+	s.rejectMove(elevatorID, floor, err)
+	return err
+}
+
+// rejectMove informs listeners and the controller a MoveTo was not performed.
+func (s *Simulation) rejectMove(elevatorID ElevatorID, floor FloorID, err error) {
+	s.logger.Debug("rejecting move", "tick", s.tick, "elevator", elevatorID, "floor", floor, "reason", err)
+	s.dispatchControllerEvent(OnElevatorMoveRejected(s.tick, elevatorID, floor))
+	if observer, ok := s.controller.(MoveRejectedObserver); ok {
+		observer.MoveRejected(elevatorID, floor, err)
+	}
+}
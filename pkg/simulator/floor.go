@@ -1,8 +1,90 @@
 package simulator
 
+// Direction is the direction of travel an actor requests when calling for an elevator.
+type Direction int
+
+const (
+	DirectionNone Direction = iota
+	DirectionUp
+	DirectionDown
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionUp:
+		return "up"
+	case DirectionDown:
+		return "down"
+	default:
+		return "none"
+	}
+}
+
+// ParseDirection finds the direction with the given name.
+func ParseDirection(name string) (Direction, bool) {
+	for _, d := range []Direction{DirectionNone, DirectionUp, DirectionDown} {
+		if d.String() == name {
+			return d, true
+		}
+	}
+	return DirectionNone, false
+}
+
+// DirectionBetween provides the direction of travel required to go from one floor to another.  Travel to the same
+// floor is considered up.
+func DirectionBetween(from FloorID, to FloorID) Direction {
+	if to < from {
+		return DirectionDown
+	}
+	return DirectionUp
+}
+
+// Floor models the hall call buttons for a single floor of the building.  A button remains lit from the time it is
+// pressed until an elevator headed in its direction opens its doors at the floor.
 type Floor struct {
+	upCalled   bool
+	downCalled bool
 }
 
 func NewFloor() *Floor {
 	return &Floor{}
 }
+
+// press lights the call button for the given direction.  True is returned if the button was not already lit.
+func (f *Floor) press(direction Direction) bool {
+	switch direction {
+	case DirectionUp:
+		if f.upCalled {
+			return false
+		}
+		f.upCalled = true
+	case DirectionDown:
+		if f.downCalled {
+			return false
+		}
+		f.downCalled = true
+	}
+	return true
+}
+
+// lit is true while the call button for the given direction awaits an elevator.
+func (f *Floor) lit(direction Direction) bool {
+	switch direction {
+	case DirectionUp:
+		return f.upCalled
+	case DirectionDown:
+		return f.downCalled
+	}
+	return false
+}
+
+// answered clears the call button for the direction the elevator at the floor is about to travel, leaving a call in the
+// other direction lit for a car headed that way.  Both buttons are cleared by cars with nowhere yet to go.
+func (f *Floor) answered(heading Direction) {
+	if heading != DirectionDown {
+		f.upCalled = false
+	}
+	if heading != DirectionUp {
+		f.downCalled = false
+	}
+}
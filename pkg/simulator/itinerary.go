@@ -0,0 +1,87 @@
+package simulator
+
+import "errors"
+
+// EnqueueStops returns the reasons any of the stops were rejected, with the remaining stops still being enqueued.
+func (s *Simulation) EnqueueStops(elevatorID ElevatorID, floors ...FloorID) error {
+	if err := s.checkCommand("EnqueueStops", elevatorID, floors...); err != nil {
+		return err
+	}
+	elevator := s.elevators[elevatorID]
+	var rejected []error
+	for _, floor := range floors {
+		if err := s.acceptMove(elevatorID, floor); err != nil {
+			rejected = append(rejected, err)
+			continue
+		}
+		s.logger.Debug("enqueuing stop", "tick", s.tick, "elevator", elevatorID, "floor", floor)
+		elevator.itinerary = append(elevator.itinerary, int(floor))
+	}
+	elevator.followItinerary(s, int(elevatorID))
+	return errors.Join(rejected...)
+}
+
+func (s *Simulation) InsertStop(elevatorID ElevatorID, position int, floor FloorID) error {
+	if err := s.checkCommand("InsertStop", elevatorID, floor); err != nil {
+		return err
+	}
+	if err := s.acceptMove(elevatorID, floor); err != nil {
+		return err
+	}
+	elevator := s.elevators[elevatorID]
+	position = min(max(position, 0), len(elevator.itinerary))
+	s.logger.Debug("inserting stop", "tick", s.tick, "elevator", elevatorID, "floor", floor, "position", position)
+	previous, planned := elevator.nextStop()
+	itinerary := make([]int, 0, len(elevator.itinerary)+1)
+	itinerary = append(itinerary, elevator.itinerary[:position]...)
+	itinerary = append(itinerary, int(floor))
+	elevator.itinerary = append(itinerary, elevator.itinerary[position:]...)
+	if position == 0 && planned {
+		elevator.retarget(s, int(elevatorID), previous)
+	}
+	elevator.followItinerary(s, int(elevatorID))
+	return nil
+}
+
+func (s *Simulation) ClearStops(elevatorID ElevatorID) error {
+	if err := s.checkCommand("ClearStops", elevatorID); err != nil {
+		return err
+	}
+	s.logger.Debug("clearing stops", "tick", s.tick, "elevator", elevatorID)
+	s.elevators[elevatorID].itinerary = nil
+	return nil
+}
+
+func (s *Simulation) Itinerary(elevatorID ElevatorID) ([]FloorID, error) {
+	if err := s.unknownElevator("Itinerary", elevatorID); err != nil {
+		return nil, err
+	}
+	return floorIDs(s.elevators[elevatorID].itinerary), nil
+}
+
+// nextStop is the first floor of the itinerary, if any.
+func (e *Elevator) nextStop() (int, bool) {
+	if len(e.itinerary) == 0 {
+		return 0, false
+	}
+	return e.itinerary[0], true
+}
+
+// followItinerary departs for the next stop when the car is idle with nothing else to do.
+func (e *Elevator) followItinerary(s *Simulation, id int) {
+	next, ok := e.nextStop()
+	if !ok || e.fault != FaultNone || e.state != Idle || e.pendingMove {
+		return
+	}
+	e.moveTo(s, id, next)
+}
+
+// retarget redirects a car travelling to the previous head of its itinerary towards the new head, such as when a stop
+// is inserted ahead of it.  Cars travelling elsewhere at the direction of the controller are left alone.
+func (e *Elevator) retarget(s *Simulation, id int, previous int) {
+	next, _ := e.nextStop()
+	if e.travelDirection() == 0 || e.moveToFloor != previous || e.fault != FaultNone {
+		return
+	}
+	e.moveTo(s, id, next)
+}
@@ -0,0 +1,189 @@
+package simulator
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// BackpressurePolicy determines how an asynchronous listener's queue behaves once it is full.
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock stalls the simulation until the listener has room for the event.
+	BackpressureBlock BackpressurePolicy = iota
+	// BackpressureDropNewest discards the event being delivered.
+	BackpressureDropNewest
+	// BackpressureDropOldest discards the oldest queued event to make room for the event being delivered.
+	BackpressureDropOldest
+)
+
+// ListenerOption configures how events are delivered to an attached ControllerListener.
+type ListenerOption func(*ListenerRegistration)
+
+// Asynchronously delivers events to the listener from a dedicated goroutine through a queue holding up to queueSize
+// events.  The policy decides what happens when the listener falls behind.
+func Asynchronously(queueSize int, policy BackpressurePolicy) ListenerOption {
+	return func(r *ListenerRegistration) {
+		if queueSize < 1 {
+			queueSize = 1
+		}
+		r.queue = make(chan Event, queueSize)
+		r.policy = policy
+	}
+}
+
+// ListenerRegistration represents a ControllerListener attached to a Simulation.
+type ListenerRegistration struct {
+	simulation *Simulation
+	listener   ControllerListener
+
+	// lock guards against delivering to the queue after it has been closed by Detach.
+	lock     sync.Mutex
+	detached bool
+	queue    chan Event
+	policy   BackpressurePolicy
+	drained  chan struct{}
+	dropped  atomic.Uint64
+}
+
+// AttachControllerListener registers the listener to receive all events produced by the simulation.  By default events
+// are delivered synchronously while the simulation is locked, so such listeners must not query the simulation.
+// Listeners attached with Asynchronously are handed the events of each tick once the simulation releases its lock, so
+// they are free to query the simulation, even when blocking it under BackpressureBlock.
+func (s *Simulation) AttachControllerListener(listener ControllerListener, options ...ListenerOption) *ListenerRegistration {
+	registration := &ListenerRegistration{simulation: s, listener: listener}
+	for _, option := range options {
+		option(registration)
+	}
+	if registration.queue != nil {
+		registration.drained = make(chan struct{})
+		go registration.deliver()
+	}
+
+	s.listenersLock.Lock()
+	defer s.listenersLock.Unlock()
+	listeners := make([]*ListenerRegistration, len(s.controllerListeners), len(s.controllerListeners)+1)
+	copy(listeners, s.controllerListeners)
+	s.controllerListeners = append(listeners, registration)
+	return registration
+}
+
+func (s *Simulation) dispatchControllerEvent(event Event) {
+	if event.EventType.hasFloor() {
+		event.FloorLabel = s.building.Label(event.Floor)
+	}
+
+	s.listenersLock.Lock()
+	listeners := s.controllerListeners
+	s.listenersLock.Unlock()
+
+	for _, l := range listeners {
+		if l.queue != nil && s.deferring {
+			s.undelivered = append(s.undelivered, undeliveredEvent{registration: l, event: event})
+			continue
+		}
+		l.dispatch(event)
+	}
+}
+
+// undeliveredEvent is an event held for an asynchronous listener until the simulation releases its lock.
+type undeliveredEvent struct {
+	registration *ListenerRegistration
+	event        Event
+}
+
+// lock takes the state lock for an operation which may produce events, holding the events for asynchronous listeners
+// until unlock.
+func (s *Simulation) lock() {
+	s.state.Lock()
+	s.deferring = true
+}
+
+// unlock releases the state lock before handing the held events to asynchronous listeners, which may block until the
+// listeners make room or query the simulation.
+func (s *Simulation) unlock() {
+	undelivered := s.undelivered
+	s.undelivered, s.deferring = nil, false
+	s.state.Unlock()
+	for _, u := range undelivered {
+		u.registration.dispatch(u.event)
+	}
+}
+
+func (r *ListenerRegistration) dispatch(event Event) {
+	if r.queue == nil {
+		r.listener.OnControllerEvent(event)
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.detached {
+		return
+	}
+	switch r.policy {
+	case BackpressureDropNewest:
+		select {
+		case r.queue <- event:
+		default:
+			r.dropped.Add(1)
+		}
+	case BackpressureDropOldest:
+		for {
+			select {
+			case r.queue <- event:
+				return
+			default:
+			}
+			select {
+			case <-r.queue:
+				r.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		r.queue <- event
+	}
+}
+
+func (r *ListenerRegistration) deliver() {
+	defer close(r.drained)
+	for event := range r.queue {
+		r.listener.OnControllerEvent(event)
+	}
+}
+
+// Dropped is the number of events discarded due to the backpressure policy.
+func (r *ListenerRegistration) Dropped() uint64 {
+	return r.dropped.Load()
+}
+
+// Detach stops delivery of further events to the listener.  Asynchronous listeners are given the opportunity to consume
+// all queued events before Detach returns.  Detaching more than once has no effect.
+func (r *ListenerRegistration) Detach() {
+	s := r.simulation
+	s.listenersLock.Lock()
+	listeners := make([]*ListenerRegistration, 0, len(s.controllerListeners))
+	for _, l := range s.controllerListeners {
+		if l != r {
+			listeners = append(listeners, l)
+		}
+	}
+	s.controllerListeners = listeners
+	s.listenersLock.Unlock()
+
+	r.lock.Lock()
+	if r.detached {
+		r.lock.Unlock()
+		return
+	}
+	r.detached = true
+	if r.queue != nil {
+		close(r.queue)
+	}
+	r.lock.Unlock()
+
+	if r.drained != nil {
+		<-r.drained
+	}
+}
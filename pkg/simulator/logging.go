@@ -0,0 +1,53 @@
+package simulator
+
+import "log/slog"
+
+// LoggerProvider may optionally be implemented by ControlledElevators to share a logger with the controllers they host.
+type LoggerProvider interface {
+	Logger() *slog.Logger
+}
+
+// discardLogger is used until a logger is supplied, keeping library use silent.
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// LoggerFor resolves the logger a controller should use for the given elevators, discarding output if none is provided.
+func LoggerFor(elevators ControlledElevators) *slog.Logger {
+	if provider, ok := elevators.(LoggerProvider); ok {
+		if logger := provider.Logger(); logger != nil {
+			return logger
+		}
+	}
+	return discardLogger
+}
+
+// SetLogger directs the diagnostic output of the simulation and its controller to the logger.  A nil logger silences
+// output, which is the default.
+func (s *Simulation) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = discardLogger
+	}
+	s.state.Lock()
+	defer s.state.Unlock()
+	s.logger = logger
+}
+
+// Logger provides the simulation's logger annotated with the current tick.
+func (s *Simulation) Logger() *slog.Logger {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	return s.tickLogger()
+}
+
+func (s *Simulation) tickLogger() *slog.Logger {
+	return s.logger.With("tick", s.tick)
+}
+
+// controlledSimulation is the view of the simulation handed to its controller.  The controller is only invoked while
+// the simulation holds its lock, so the logger is resolved without taking the lock again.
+type controlledSimulation struct {
+	*Simulation
+}
+
+func (c controlledSimulation) Logger() *slog.Logger {
+	return c.tickLogger()
+}
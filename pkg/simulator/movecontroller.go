@@ -18,7 +18,7 @@ func (m *MoveController) Init(elevators []ElevatorID) {
 	m.elevatorID = elevators[0]
 }
 
-func (m *MoveController) Called(floor FloorID) {
+func (m *MoveController) Called(floor FloorID, direction Direction) {
 	m.simulation.MoveTo(m.elevatorID, floor)
 }
 func (m *MoveController) FloorSelected(elevatorID ElevatorID, floor FloorID) {
@@ -0,0 +1,187 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Journey is the timeline of a single leg of an actor's itinerary.  Ticks which have not yet occurred are -1.
+type Journey struct {
+	// Entity identifies the actor within the simulation, or -1 if the actor has not yet started.
+	Entity EntityID `json:"entity"`
+	// Leg is the index of the leg within the actor's itinerary.
+	Leg      int  `json:"leg"`
+	CalledAt Tick `json:"calledAt"`
+	// BoardedAt is when the actor first entered an elevator.  Time spent transferring between cars counts towards the
+	// ride.
+	BoardedAt Tick `json:"boardedAt"`
+	ArrivedAt Tick `json:"arrivedAt"`
+	// AbandonedAt is when the actor gave up waiting for an elevator.
+	AbandonedAt Tick `json:"abandonedAt"`
+}
+
+// Boarded is true once the actor has entered an elevator.
+func (j Journey) Boarded() bool {
+	return j.BoardedAt >= 0
+}
+
+// Completed is true once the actor has arrived at their goal.
+func (j Journey) Completed() bool {
+	return j.ArrivedAt >= 0
+}
+
+// Abandoned is true if the actor gave up waiting for an elevator.
+func (j Journey) Abandoned() bool {
+	return j.AbandonedAt >= 0
+}
+
+// WaitTime is the time between the actor calling for an elevator and boarding one.
+func (j Journey) WaitTime() Tick {
+	return j.BoardedAt - j.CalledAt
+}
+
+// RideTime is the time the actor spent within the elevator.
+func (j Journey) RideTime() Tick {
+	return j.ArrivedAt - j.BoardedAt
+}
+
+// JourneyTime is the total time from the actor calling for an elevator to arriving at their goal.
+func (j Journey) JourneyTime() Tick {
+	return j.ArrivedAt - j.CalledAt
+}
+
+// Statistic summarizes a set of durations.
+type Statistic struct {
+	Count   int     `json:"count"`
+	Average float64 `json:"average"`
+	Max     Tick    `json:"max"`
+	P95     Tick    `json:"p95"`
+}
+
+// Summarize builds a Statistic from the given durations.  The 95th percentile uses the nearest-rank method.
+func Summarize(durations []Tick) Statistic {
+	if len(durations) == 0 {
+		return Statistic{}
+	}
+	sorted := make([]Tick, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total Tick
+	for _, d := range sorted {
+		total += d
+	}
+	rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+	return Statistic{
+		Count:   len(sorted),
+		Average: float64(total) / float64(len(sorted)),
+		Max:     sorted[len(sorted)-1],
+		P95:     sorted[rank],
+	}
+}
+
+// Score rolls up the journeys of all actors within a scenario, with each leg of an actor's itinerary scored as a
+// separate journey.  Wait times consider all legs where the actor has boarded while ride and journey times only
+// consider legs where the actor has arrived.
+type Score struct {
+	Actors int `json:"actors"`
+	// Legs is the number of legs across the itineraries of all actors.
+	Legs int `json:"legs"`
+	// Completed and Abandoned count legs.
+	Completed int       `json:"completed"`
+	Abandoned int       `json:"abandoned"`
+	Wait      Statistic `json:"wait"`
+	Ride      Statistic `json:"ride"`
+	Journey   Statistic `json:"journey"`
+	// Energy is the total energy consumed by all elevators during the run.
+	Energy Energy `json:"energy"`
+	// ControllerFaults counts the commands the controller issued which could not be carried out, as held against the
+	// controller by the simulation's ControllerFaultPolicy.
+	ControllerFaults int `json:"controllerFaults"`
+	// Disqualified is true when the run was ended by a controller fault.
+	Disqualified bool `json:"disqualified"`
+}
+
+// ScoreWeights combine the terms of a Score into a single cost for ranking controllers.  Terms with a zero weight do not
+// contribute, allowing terms such as Energy to be optionally considered.
+type ScoreWeights struct {
+	AverageJourney float64
+	MaxJourney     float64
+	Undelivered    float64
+	Abandoned      float64
+	Energy         float64
+	// ControllerFault is the penalty for each controller fault held against the controller.
+	ControllerFault float64
+}
+
+// DefaultScoreWeights ranks controllers on passenger time alone, penalizing controller faults.
+func DefaultScoreWeights() ScoreWeights {
+	return ScoreWeights{
+		AverageJourney:  1,
+		MaxJourney:      0.5,
+		Undelivered:     100,
+		Abandoned:       100,
+		ControllerFault: 100,
+	}
+}
+
+// Cost combines the score into a single figure using the given weights.  Lower costs are better, with a disqualified
+// controller having an infinite cost.
+func (s Score) Cost(weights ScoreWeights) float64 {
+	if s.Disqualified {
+		return math.Inf(1)
+	}
+	return weights.AverageJourney*s.Journey.Average +
+		weights.MaxJourney*float64(s.Journey.Max) +
+		weights.Undelivered*float64(s.Legs-s.Completed-s.Abandoned) +
+		weights.Abandoned*float64(s.Abandoned) +
+		weights.Energy*float64(s.Energy) +
+		weights.ControllerFault*float64(s.ControllerFaults)
+}
+
+// ScoreJourneys computes the Score for the given journeys.
+func ScoreJourneys(journeys []Journey) Score {
+	var waits, rides, totals []Tick
+	actors, completed, abandoned := 0, 0, 0
+	for _, j := range journeys {
+		if j.Leg == 0 {
+			actors++
+		}
+		if j.Abandoned() {
+			abandoned++
+		}
+		if j.Boarded() {
+			waits = append(waits, j.WaitTime())
+		}
+		if j.Completed() {
+			completed++
+			rides = append(rides, j.RideTime())
+			totals = append(totals, j.JourneyTime())
+		}
+	}
+	return Score{
+		Actors:    actors,
+		Legs:      len(journeys),
+		Completed: completed,
+		Abandoned: abandoned,
+		Wait:      Summarize(waits),
+		Ride:      Summarize(rides),
+		Journey:   Summarize(totals),
+	}
+}
+
+func (s Statistic) String() string {
+	return fmt.Sprintf("avg %.2f, max %d, p95 %d", s.Average, s.Max, s.P95)
+}
+
+func (s Score) String() string {
+	out := fmt.Sprintf("%d of %d legs delivered for %d actors, %d abandoned; wait (%s); ride (%s); journey (%s); energy %.2f", s.Completed, s.Legs, s.Actors, s.Abandoned, s.Wait, s.Ride, s.Journey, s.Energy)
+	if s.ControllerFaults > 0 {
+		out += fmt.Sprintf("; %d controller faults", s.ControllerFaults)
+	}
+	if s.Disqualified {
+		out += "; disqualified"
+	}
+	return out
+}
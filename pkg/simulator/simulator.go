@@ -1,31 +1,66 @@
 package simulator
 
-import "fmt"
+import (
+	"log/slog"
+	"sync"
+)
 
 type Tick int64
 
 // Simulation encapsulates game state over time.
 //
-// An instance of Simulation is not guaranteed to be thread safe.
+// The exported query methods of a Simulation are safe to call concurrently with a running tick; they wait for the tick
+// to complete.  The ControlledElevators methods are intended for the attached Controller and must only be invoked from
+// within the Controller's callbacks or while no tick is in progress.
 type Simulation struct {
-	tick                Tick
-	elevators           []*Elevator
-	floors              []*Floor
-	actors              []*Actor
-	enteredActors       []*actorState
-	controller          Controller
-	controllerListeners []ControllerListener
+	state         sync.RWMutex
+	tick          Tick
+	elevators     []*Elevator
+	floors        []*Floor
+	building      Building
+	faults        []Fault
+	actors        []*Actor
+	enteredActors []*actorState
+	controller    Controller
+	logger        *slog.Logger
+	// controllerFaults counts the faults held against the controller, with disqualification being the fault which ended
+	// the simulation under DisqualifyControllerFaults.
+	controllerFaultPolicy ControllerFaultPolicy
+	controllerFaults      int
+	disqualification      *ControllerFaultError
+
+	// listenersLock guards controllerListeners, which is replaced rather than modified so dispatch may iterate a
+	// consistent set of listeners without holding the lock.
+	listenersLock       sync.Mutex
+	controllerListeners []*ListenerRegistration
+	// deferring is set while the state is locked for an operation producing events, during which events for
+	// asynchronous listeners are held within undelivered until the lock is released.
+	deferring   bool
+	undelivered []undeliveredEvent
 }
 
 // Tick advances the simulation by a single tick.  For each tick the following occurs:
 //   - elevators are notified
 //   - actors are notified
 //
-// True is returned if all existing actors have completed their objectives
+// Events produced during the tick are stamped with the tick, after which the simulation moves on to the next tick.
+//
+// True is returned while actors have yet to complete their objectives.  A simulation whose controller has been
+// disqualified no longer advances and returns false.
 func (s *Simulation) Tick() bool {
+	s.lock()
+	defer s.unlock()
+
+	if s.disqualification != nil {
+		return false
+	}
+
 	currentTick := s.tick
-	s.tick++
 	s.dispatchControllerEvent(OnTickStart(currentTick))
+	s.applyFaults(currentTick)
+	if observer, ok := s.controller.(TickObserver); ok {
+		observer.Tick(currentTick)
+	}
 	for i, elevator := range s.elevators {
 		elevator.Tick(s, i, currentTick)
 	}
@@ -34,7 +69,8 @@ func (s *Simulation) Tick() bool {
 		actor.Tick(s, currentTick)
 	}
 	s.dispatchControllerEvent(OnTickDone(currentTick))
-	return !s.ActorsCompletedObjectives()
+	s.tick++
+	return s.disqualification == nil && !s.actorsCompletedObjectives()
 }
 
 // TickUpTo advances the Simulation by up to the additional count of ticks or all actors have completed their objectives,
@@ -42,15 +78,21 @@ func (s *Simulation) Tick() bool {
 //
 // Current simulation tick is returned as a result.
 func (s *Simulation) TickUpTo(additional Tick) Tick {
-	endTick := s.tick + additional
-	for s.tick < endTick && s.Tick() {
+	endTick := s.CurrentTick() + additional
+	for s.CurrentTick() < endTick && s.Tick() {
 	}
-	return s.tick
+	return s.CurrentTick()
 }
 
-// ActorsCompletedObjectives checks if all registered actors have completed their objectives.  If all actors have
-// completed their objectives then true is returned, otherwise false.
+// ActorsCompletedObjectives checks if all registered actors have resolved their objectives, either by completing them
+// or by abandoning them.  If all actors have resolved their objectives then true is returned, otherwise false.
 func (s *Simulation) ActorsCompletedObjectives() bool {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	return s.actorsCompletedObjectives()
+}
+
+func (s *Simulation) actorsCompletedObjectives() bool {
 	//TODO: Ideally there is a better way to structure this
 	for _, actor := range s.actors {
 		if !actor.done() {
@@ -61,50 +103,134 @@ func (s *Simulation) ActorsCompletedObjectives() bool {
 }
 
 // CurrentTick provides the tick the simulator is at.
-//
-// NOTE: An instance of Simulation provides no multithreading consistency guarantees.
 func (s *Simulation) CurrentTick() Tick {
+	s.state.RLock()
+	defer s.state.RUnlock()
 	return s.tick
 }
 
+// Journeys provides the timeline of each leg of the attached actors, in the order the actors were attached.
+func (s *Simulation) Journeys() []Journey {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	return s.journeys()
+}
+
+func (s *Simulation) journeys() []Journey {
+	journeys := make([]Journey, 0, len(s.actors))
+	for _, a := range s.actors {
+		journeys = append(journeys, a.journeys()...)
+	}
+	return journeys
+}
+
+// Score rolls up the journeys of all attached actors along with the energy consumed by the elevators.
+func (s *Simulation) Score() Score {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	score := ScoreJourneys(s.journeys())
+	score.Energy = s.energy()
+	score.ControllerFaults = s.controllerFaults
+	score.Disqualified = s.disqualification != nil
+	return score
+}
+
+// Building describes the floors of the simulation.
+func (s *Simulation) Building() Building {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	return s.building
+}
+
+// ElevatorCount is the number of elevators within the simulation.
+func (s *Simulation) ElevatorCount() int {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	return len(s.elevators)
+}
+
 func (s *Simulation) AttachActor(actor *Actor) {
+	s.lock()
+	defer s.unlock()
 	s.actors = append(s.actors, actor)
 }
 
 type ControllerFunc func(ControlledElevators) Controller
 
 func (s *Simulation) AttachControllerFunc(factory ControllerFunc) {
-	s.controller = factory(s)
+	s.lock()
+	defer s.unlock()
+	s.attachController(factory)
+}
+
+func (s *Simulation) attachController(factory ControllerFunc) {
+	s.controller = factory(controlledSimulation{s})
 	ids := make([]ElevatorID, len(s.elevators))
 	for i := range s.elevators {
 		ids[i] = ElevatorID(i)
 	}
+	if aware, ok := s.controller.(BuildingController); ok {
+		aware.InitBuilding(s.building)
+	}
+	if zoned, ok := s.controller.(ZonedController); ok {
+		zoned.InitZones(s.servedFloors())
+	}
 	s.controller.Init(ids)
 }
 
-func (s *Simulation) MoveTo(elevatorID ElevatorID, floor FloorID) {
-	fmt.Printf("Simulation{tick: %d} -- Moving elevator %d to %d\n", s.tick, elevatorID, floor)
+// servedFloors lists the floors served by each elevator.
+func (s *Simulation) servedFloors() ServedFloors {
+	served := make(ServedFloors, len(s.elevators))
+	for i, e := range s.elevators {
+		floors := make([]FloorID, 0, len(s.floors))
+		for floor := range s.floors {
+			if e.serves(floor) {
+				floors = append(floors, FloorID(floor))
+			}
+		}
+		served[ElevatorID(i)] = floors
+	}
+	return served
+}
+
+func (s *Simulation) MoveTo(elevatorID ElevatorID, floor FloorID) error {
+	if err := s.checkCommand("MoveTo", elevatorID, floor); err != nil {
+		return err
+	}
+	if err := s.acceptMove(elevatorID, floor); err != nil {
+		return err
+	}
 	elevator := s.elevators[elevatorID]
+	s.logger.Debug("moving elevator", "tick", s.tick, "elevator", elevatorID, "floor", floor)
 	elevator.moveTo(s, int(elevatorID), int(floor))
+	return nil
 }
 
 const (
 	PlaceFloor = iota
 	PlaceElevator
+	// PlaceOutside is for actors which have left the simulation without reaching their goal.
+	PlaceOutside
+	// PlaceStairs is for actors walking between floors, with the place index being the floor they are walking to.
+	PlaceStairs
 )
 
 type actorState struct {
 	placeType  int
 	placeIndex int
+	actor      *Actor
 }
 
 func (s *Simulation) StartAt(a *Actor, floor int) int {
 	state := &actorState{
 		placeType:  PlaceFloor,
 		placeIndex: floor,
+		actor:      a,
 	}
 	id := len(s.enteredActors)
 	s.enteredActors = append(s.enteredActors, state)
+	s.dispatchControllerEvent(OnActorSpawned(s.tick, EntityID(id), FloorID(floor)))
 	return id
 }
 
@@ -114,7 +240,7 @@ func (s *Simulation) ElevatorsAt(id int) []int {
 	switch state.placeType {
 	case PlaceFloor:
 		for id, e := range s.elevators {
-			if e.currentFloor == state.placeIndex {
+			if e.isAtFloor(s, FloorID(state.placeIndex)) {
 				found = append(found, id)
 			}
 		}
@@ -122,6 +248,75 @@ func (s *Simulation) ElevatorsAt(id int) []int {
 	return found
 }
 
+// actorFloor is the floor the actor is waiting on, or -1 if the actor is not on a floor.
+func (s *Simulation) actorFloor(actorID int) int {
+	state := s.enteredActors[actorID]
+	if state.placeType != PlaceFloor {
+		return -1
+	}
+	return state.placeIndex
+}
+
+// elevatorServes is true if the elevator is able to stop at the floor.
+func (s *Simulation) elevatorServes(elevatorID int, floor int) bool {
+	return s.elevators[elevatorID].serves(floor)
+}
+
+// routeLeg picks the floor an actor on the from floor should ride to next on their way to the goal.  Any car serving
+// both floors takes the actor directly.  Otherwise the actor rides to the transfer floor closest to their goal which is
+// shared with a car serving the goal, such as a sky lobby.  The goal is returned when no route exists.
+func (s *Simulation) routeLeg(from int, goal int) int {
+	transfer := -1
+	for _, e := range s.elevators {
+		if !e.serves(from) {
+			continue
+		}
+		if e.serves(goal) {
+			return goal
+		}
+		for _, other := range s.elevators {
+			if !other.serves(goal) {
+				continue
+			}
+			for floor := range s.floors {
+				if floor == from || !e.serves(floor) || !other.serves(floor) {
+					continue
+				}
+				if transfer == -1 || abs(floor-goal) < abs(transfer-goal) {
+					transfer = floor
+				}
+			}
+		}
+	}
+	if transfer == -1 {
+		return goal
+	}
+	return transfer
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// elevatorOnActorsFloor is true while the elevator is stopped on the same floor as the actor cycling its doors,
+// regardless of whether the doors are open.  Cars which close their doors and idle on the floor are no longer
+// considered to be stopped for the actor.
+func (s *Simulation) elevatorOnActorsFloor(actorID int, elevatorID int) bool {
+	state := s.enteredActors[actorID]
+	elevator := s.elevators[elevatorID]
+	if state.placeType != PlaceFloor || elevator.currentFloor != state.placeIndex {
+		return false
+	}
+	switch elevator.state {
+	case DoorsOpening, DoorsOpen, DoorsClosing:
+		return true
+	}
+	return false
+}
+
 func (s *Simulation) ElevatorAtFloor(actorID int, floor FloorID) bool {
 	state := s.enteredActors[actorID]
 	switch state.placeType {
@@ -132,26 +327,99 @@ func (s *Simulation) ElevatorAtFloor(actorID int, floor FloorID) bool {
 	return false
 }
 
-func (s *Simulation) Enter(actorID int, elevatorID int) {
+// Enter attempts to board the actor onto the given elevator.  True is returned if the actor is now within the elevator.
+// If the elevator is already at capacity, or the actor's load would exceed the rated load of the elevator, the actor
+// remains on the floor and an ActorBoardingRejected event is emitted.
+func (s *Simulation) Enter(actorID int, elevatorID int) bool {
 	state := s.enteredActors[actorID]
 	switch state.placeType {
 	case PlaceFloor:
-		if s.elevators[elevatorID].currentFloor == state.placeIndex {
-			state.placeType = PlaceElevator
-			state.placeIndex = elevatorID
+		elevator := s.elevators[elevatorID]
+		if !elevator.isAtFloor(s, FloorID(state.placeIndex)) {
+			return false
+		}
+		if s.ridersIn(elevatorID) >= int(elevator.capacity) || !elevator.admits(s.loadIn(elevatorID), state.actor.load) {
+			s.dispatchControllerEvent(OnActorBoardingRejected(s.tick, EntityID(actorID), ElevatorID(elevatorID), FloorID(state.placeIndex)))
+			return false
+		}
+		floor := state.placeIndex
+		state.placeType = PlaceElevator
+		state.placeIndex = elevatorID
+		if state.actor.boardedTick == -1 {
+			state.actor.boardedTick = s.tick
+		}
+		s.dispatchControllerEvent(OnActorBoarded(s.tick, EntityID(actorID), ElevatorID(elevatorID), FloorID(floor)))
+		return true
+	}
+	return false
+}
+
+// ridersIn counts the actors currently within the given elevator.
+func (s *Simulation) ridersIn(elevatorID int) int {
+	count := 0
+	for _, a := range s.enteredActors {
+		if a.placeType == PlaceElevator && a.placeIndex == elevatorID {
+			count++
 		}
 	}
+	return count
 }
 
-func (s *Simulation) exitElevator(actorID int) {
+// loadIn totals the load of the actors currently within the given elevator.
+func (s *Simulation) loadIn(elevatorID int) Load {
+	var load Load
+	for _, a := range s.enteredActors {
+		if a.placeType == PlaceElevator && a.placeIndex == elevatorID {
+			load += a.actor.load
+		}
+	}
+	return load
+}
+
+// exitElevator places an actor riding an elevator onto the elevator's floor.  False is returned if the actor is not
+// within an elevator.
+func (s *Simulation) exitElevator(actorID int) bool {
 	state := s.enteredActors[actorID]
-	switch state.placeType {
-	case PlaceElevator:
-		state.placeType = PlaceFloor
-		state.placeIndex = s.elevators[state.placeIndex].currentFloor
-	default:
-		panic("not in elevator")
+	if state.placeType != PlaceElevator {
+		return false
 	}
+	elevatorID := state.placeIndex
+	state.placeType = PlaceFloor
+	state.placeIndex = s.elevators[elevatorID].currentFloor
+	s.dispatchControllerEvent(OnActorExited(s.tick, EntityID(actorID), ElevatorID(elevatorID), FloorID(state.placeIndex)))
+	return true
+}
+
+// actorElevator is the elevator the actor is riding, or -1 if the actor is not within an elevator.
+func (s *Simulation) actorElevator(actorID int) int {
+	state := s.enteredActors[actorID]
+	if state.placeType != PlaceElevator {
+		return -1
+	}
+	return state.placeIndex
+}
+
+// takeStairs moves an actor from their floor onto the stairs towards the given floor.
+func (s *Simulation) takeStairs(actorID int, floor int) {
+	state := s.enteredActors[actorID]
+	state.placeType = PlaceStairs
+	state.placeIndex = floor
+	s.dispatchControllerEvent(OnActorTookStairs(s.tick, EntityID(actorID), FloorID(floor)))
+}
+
+// leaveStairs places an actor on the stairs onto the floor they were walking to.
+func (s *Simulation) leaveStairs(actorID int) {
+	state := s.enteredActors[actorID]
+	state.placeType = PlaceFloor
+}
+
+// abandon removes an actor waiting on a floor from the building.
+func (s *Simulation) abandon(actorID int) {
+	state := s.enteredActors[actorID]
+	floor := state.placeIndex
+	state.placeType = PlaceOutside
+	state.placeIndex = -1
+	s.dispatchControllerEvent(OnActorAbandoned(s.tick, EntityID(actorID), FloorID(floor)))
 }
 
 func (s *Simulation) PressButton(actorID int, floor int) {
@@ -159,62 +427,175 @@ func (s *Simulation) PressButton(actorID int, floor int) {
 	switch state.placeType {
 	case PlaceElevator:
 		elevator := s.elevators[state.placeIndex]
+		elevator.selectFloor(floor)
 		s.controller.FloorSelected(ElevatorID(state.placeIndex), FloorID(floor))
-		elevator.desiredFloors = append(elevator.desiredFloors, floor)
-		s.dispatchControllerEvent(OnElevatorFloorRequest(s.tick, ElevatorID(state.placeIndex), FloorID(floor)))
+		s.dispatchControllerEvent(OnElevatorFloorRequest(s.tick, EntityID(actorID), ElevatorID(state.placeIndex), FloorID(floor)))
 	}
 }
 
 func (s *Simulation) elevatorDoneMoving(elevatorID ElevatorID) {
-	for i, a := range s.enteredActors {
+	s.logger.Debug("elevator done moving", "tick", s.tick, "elevator", elevatorID, "floor", s.elevators[elevatorID].currentFloor)
+}
+
+// elevatorDoorsOpened allows the riders of the elevator to exit at the current floor.
+func (s *Simulation) elevatorDoorsOpened(elevatorID ElevatorID) {
+	floor := s.elevators[elevatorID].currentFloor
+	s.elevators[elevatorID].answerCarCall(floor)
+	s.answerHallCalls(elevatorID, floor)
+	s.dispatchControllerEvent(OnDoorsOpened(s.tick, elevatorID, FloorID(floor)))
+	for actorID, a := range s.enteredActors {
 		if a.placeType == PlaceElevator && a.placeIndex == int(elevatorID) {
-			s.actors[i].elevatorStopped(s, s.tick, s.elevators[elevatorID].currentFloor)
-			s.dispatchControllerEvent(OnElevatorArrived(s.tick, elevatorID, FloorID(s.elevators[elevatorID].currentFloor)))
+			s.dispatchControllerEvent(OnElevatorArrived(s.tick, EntityID(actorID), elevatorID, FloorID(floor)))
+			a.actor.elevatorStopped(s, s.tick, floor)
 		}
 	}
-	fmt.Printf("Simulation{tick: %d} -- Elevator{id: %d} is at floor %d\n", s.tick, elevatorID, s.elevators[elevatorID].currentFloor)
+}
+
+// elevatorDoorsClosed completes the stop of an elevator, informing the controller the car is ready for another move.
+func (s *Simulation) elevatorDoorsClosed(elevatorID ElevatorID) {
+	floor := s.elevators[elevatorID].currentFloor
+	s.dispatchControllerEvent(OnDoorsClosed(s.tick, elevatorID, FloorID(floor)))
 	s.controller.CompletedMove(elevatorID)
+	s.recallUnanswered(floor)
 }
 
-func (s *Simulation) elevatorOnFloor(elevatorID ElevatorID, floor FloorID) {
-	fmt.Printf("Simulation{tick: %d} -- Elevator{id: %d} arrived at floor %d\n", s.tick, elevatorID, floor)
-	s.dispatchControllerEvent(Event{
-		EventType: ElevatorAtFloor,
-		Elevator:  elevatorID,
-		Floor:     floor,
-	})
+// recallUnanswered informs the controller once more of calls left lit on the floor as a car closes its doors, such as
+// by a car headed the other way or unable to reach the floors the callers are riding to, as the controller may consider
+// the call served by the car's stop.  Buttons with no actor left waiting on them are cleared.
+func (s *Simulation) recallUnanswered(floor int) {
+	hall := s.floors[floor]
+	for _, direction := range []Direction{DirectionUp, DirectionDown} {
+		if !hall.lit(direction) {
+			continue
+		}
+		actorID, waiting := s.waitingFor(floor, direction, -1)
+		if !waiting {
+			hall.answered(direction)
+			continue
+		}
+		s.dispatchControllerEvent(OnElevatorCalled(s.tick, EntityID(actorID), FloorID(floor), direction))
+		s.controller.Called(FloorID(floor), direction)
+	}
 }
 
-func (s *Simulation) callElevator(floor int) {
-	s.dispatchControllerEvent(OnElevatorCalled(s.tick, FloorID(floor)))
-	s.controller.Called(FloorID(floor))
+// answerHallCalls clears the call buttons on the floor answered by the elevator opening its doors, being those for the
+// direction the car is headed.  Calls are left lit when the car serves none of the floors the actors waiting on them
+// are riding to.
+func (s *Simulation) answerHallCalls(elevatorID ElevatorID, floor int) {
+	heading := headingOf(s.status(elevatorID))
+	for _, direction := range []Direction{DirectionUp, DirectionDown} {
+		if heading != DirectionNone && heading != direction {
+			continue
+		}
+		_, unserved := s.waitingFor(floor, direction, -1)
+		_, served := s.waitingFor(floor, direction, int(elevatorID))
+		if unserved && !served {
+			continue
+		}
+		s.floors[floor].answered(direction)
+	}
 }
 
-func (s *Simulation) Initialize(elevators int, floors int) {
-	s.dispatchControllerEvent(OnInitStart())
-	s.elevators = make([]*Elevator, elevators)
-	for i := range s.elevators {
-		s.elevators[i] = NewElevator(5)
-		s.dispatchControllerEvent(OnInformElevator(ElevatorID(i)))
+// waitingFor finds an actor waiting on the floor for a car headed in the given direction.  When given an elevator, only
+// actors riding to a floor the elevator serves are considered.
+func (s *Simulation) waitingFor(floor int, direction Direction, elevatorID int) (int, bool) {
+	for actorID, state := range s.enteredActors {
+		a := state.actor
+		if state.placeType != PlaceFloor || state.placeIndex != floor || a.state != WaitingOnFloor ||
+			DirectionBetween(FloorID(floor), FloorID(a.legGoal)) != direction {
+			continue
+		}
+		if elevatorID < 0 || s.elevatorServes(elevatorID, a.legGoal) {
+			return actorID, true
+		}
 	}
-	s.floors = make([]*Floor, floors)
-	for i := range s.floors {
-		s.floors[i] = NewFloor()
-		s.dispatchControllerEvent(OnInformFloor(FloorID(i)))
+	return -1, false
+}
+
+// elevatorMoveDeferred informs the controller a move could not be performed until the elevator completes its current
+// stop.
+func (s *Simulation) elevatorMoveDeferred(elevatorID ElevatorID, floor FloorID) {
+	s.dispatchControllerEvent(OnElevatorMoveDeferred(s.tick, elevatorID, floor))
+	if observer, ok := s.controller.(MoveDeferredObserver); ok {
+		observer.MoveDeferred(elevatorID, floor)
 	}
-	s.dispatchControllerEvent(OnInitDone())
 }
 
-// TODO: Multithreading
-func (s *Simulation) dispatchControllerEvent(event Event) {
-	for _, l := range s.controllerListeners {
-		l.OnControllerEvent(event)
+// elevatorServedStop records the elevator has stopped at the next floor of its itinerary.
+func (s *Simulation) elevatorServedStop(elevatorID ElevatorID) {
+	floor := FloorID(s.elevators[elevatorID].currentFloor)
+	s.logger.Debug("elevator served stop", "tick", s.tick, "elevator", elevatorID, "floor", floor)
+	s.dispatchControllerEvent(OnElevatorStopServed(s.tick, elevatorID, floor))
+}
+
+func (s *Simulation) elevatorOnFloor(elevatorID ElevatorID, floor FloorID) {
+	s.logger.Debug("elevator at floor", "tick", s.tick, "elevator", elevatorID, "floor", floor)
+	s.dispatchControllerEvent(OnElevatorAtFloor(s.tick, elevatorID, floor))
+}
+
+// callElevator presses the hall call button for the given direction on the floor.  The controller is only informed when
+// the button was not already lit.  Calls made while an elevator headed in the direction has its doors open at the floor
+// are answered immediately and do not leave the button lit.
+func (s *Simulation) callElevator(actorID int, floor int, direction Direction) {
+	hall := s.floors[floor]
+	if !hall.press(direction) {
+		return
+	}
+	if s.doorsOpenAt(floor, direction, s.enteredActors[actorID].actor.legGoal) {
+		hall.answered(direction)
 	}
+	s.dispatchControllerEvent(OnElevatorCalled(s.tick, EntityID(actorID), FloorID(floor), direction))
+	s.controller.Called(FloorID(floor), direction)
 }
 
-// TODO: Multithreading
-func (s *Simulation) AttachControllerListener(listener ControllerListener) {
-	s.controllerListeners = append(s.controllerListeners, listener)
+// doorsOpenAt is true when an elevator serving the goal, with nowhere yet to go or headed in the given direction, has its
+// doors open at the floor.
+func (s *Simulation) doorsOpenAt(floor int, direction Direction, goal int) bool {
+	for i, e := range s.elevators {
+		if !e.isAtFloor(s, FloorID(floor)) || !e.serves(goal) {
+			continue
+		}
+		if heading := headingOf(s.status(ElevatorID(i))); heading == DirectionNone || heading == direction {
+			return true
+		}
+	}
+	return false
+}
+
+// Initialize builds the building with the given number of floors and elevators using DefaultElevatorConfig.
+func (s *Simulation) Initialize(elevators int, floors int) {
+	fleet := make([]ElevatorConfig, elevators)
+	for i := range fleet {
+		fleet[i] = DefaultElevatorConfig()
+	}
+	s.InitializeFleet(floors, fleet)
+}
+
+// InitializeFleet builds the building with the given number of floors and an elevator for each of the supplied
+// configurations.
+func (s *Simulation) InitializeFleet(floors int, fleet []ElevatorConfig) {
+	s.InitializeBuilding(NumberedBuilding(floors), fleet)
+}
+
+// InitializeBuilding builds the described building with an elevator for each of the supplied configurations.
+func (s *Simulation) InitializeBuilding(building Building, fleet []ElevatorConfig) {
+	s.lock()
+	defer s.unlock()
+
+	floors := len(building.Floors)
+	s.building = building
+	s.dispatchControllerEvent(OnInitStart(s.tick))
+	s.elevators = make([]*Elevator, len(fleet))
+	for i, config := range fleet {
+		s.elevators[i] = NewConfiguredElevator(config)
+		s.dispatchControllerEvent(OnInformElevator(s.tick, ElevatorID(i)))
+	}
+	s.floors = make([]*Floor, floors)
+	for i := range s.floors {
+		s.floors[i] = NewFloor()
+		s.dispatchControllerEvent(OnInformFloor(s.tick, FloorID(i)))
+	}
+	s.dispatchControllerEvent(OnInitDone(s.tick))
 }
 
 func NewSimulation() *Simulation {
@@ -223,7 +604,8 @@ func NewSimulation() *Simulation {
 		elevators:           make([]*Elevator, 0),
 		floors:              make([]*Floor, 0),
 		actors:              make([]*Actor, 0),
-		controllerListeners: make([]ControllerListener, 0),
+		controllerListeners: make([]*ListenerRegistration, 0),
+		logger:              discardLogger,
 	}
 	return s
 }
@@ -1,8 +1,14 @@
 package simulator
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
 	"testing"
+	"time"
 )
 
 func TestSimulatorRunsForTicks(t *testing.T) {
@@ -93,3 +99,1510 @@ func TestElevatorsMoveDown(t *testing.T) {
 		t.Errorf("Exceeded tick count @ %d", s.tick)
 	}
 }
+
+func TestElevatorRefusesActorsBeyondCapacity(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	for i := 0; i < DefaultElevatorCapacity+1; i++ {
+		s.AttachActor(NewActor(1, 0, 0))
+	}
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 2)
+	s.AttachControllerFunc(NewMoveController)
+
+	s.TickUpTo(2)
+	if riders := s.ridersIn(0); riders != DefaultElevatorCapacity {
+		t.Errorf("Expected %d riders, got %d", DefaultElevatorCapacity, riders)
+	}
+	rejected := 0
+	for _, e := range capture.Events {
+		if e.EventType == ActorBoardingRejected {
+			rejected++
+		}
+	}
+	if rejected != 1 {
+		t.Errorf("Expected a single rejected boarding, got %d", rejected)
+	}
+
+	endTick := s.TickUpTo(20)
+	if !s.ActorsCompletedObjectives() {
+		t.Errorf("Expected all actors to eventually be delivered, stopped @ %d", endTick)
+	}
+}
+
+func TestElevatorRefusesActorsBeyondRatedLoad(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachActor(NewActor(1, 0, 0, WithLoad(300)))
+	s.AttachActor(NewActor(1, 0, 0))
+	s.AttachActor(NewActor(1, 0, 0, WithLoad(150)))
+	s.AttachControllerListener(capture)
+	config := DefaultElevatorConfig()
+	config.RatedLoad = 400
+	s.InitializeFleet(2, []ElevatorConfig{config})
+	s.AttachControllerFunc(NewMoveController)
+
+	s.TickUpTo(2)
+	status := s.status(0)
+	if status.Riders != 2 || status.Load != 375 || status.RatedLoad != 400 {
+		t.Errorf("Expected the cart and a person to fill the car, got %+v", status)
+	}
+	rejected := 0
+	for _, e := range capture.Events {
+		if e.EventType == ActorBoardingRejected && e.Entity == 2 {
+			rejected++
+		}
+	}
+	if rejected != 1 {
+		t.Errorf("Expected the wheelchair user to be turned away once, got %d", rejected)
+	}
+
+	endTick := s.TickUpTo(20)
+	if !s.ActorsCompletedObjectives() {
+		t.Errorf("Expected all actors to eventually be delivered, stopped @ %d", endTick)
+	}
+}
+
+func TestDoorsCycleForConfiguredTicks(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachActor(NewActor(1, 0, 0))
+	s.AttachControllerListener(capture)
+	s.InitializeFleet(2, []ElevatorConfig{{
+		Capacity:          DefaultElevatorCapacity,
+		DoorsOpeningTicks: 2,
+		DwellTicks:        3,
+		DoorsClosingTicks: 2,
+	}})
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(40)
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected actor to complete objective")
+	}
+
+	var called, opened, closed []Tick
+	for _, e := range capture.Events {
+		switch e.EventType {
+		case ElevatorCalled:
+			called = append(called, e.Timestamp)
+		case DoorsOpened:
+			opened = append(opened, e.Timestamp)
+		case DoorsClosed:
+			closed = append(closed, e.Timestamp)
+		}
+	}
+	if len(opened) < 2 || len(closed) < 1 {
+		t.Fatalf("Expected doors to cycle at each stop, opened %v, closed %v", opened, closed)
+	}
+	if opening := opened[0] - called[0]; opening != 2 {
+		t.Errorf("Expected doors to open after 2 ticks, took %d", opening)
+	}
+	if cycle := closed[0] - opened[0]; cycle != 5 {
+		t.Errorf("Expected doors to dwell and close over 5 ticks, took %d", cycle)
+	}
+}
+
+func TestElevatorTravelsWithKinematicProfile(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0))
+	s.AttachControllerListener(capture)
+	config := DefaultElevatorConfig()
+	config.TicksPerFloor = 2
+	config.AccelerationTicks = 3
+	config.DecelerationTicks = 1
+	s.InitializeFleet(5, []ElevatorConfig{config})
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(60)
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected actor to complete objective")
+	}
+
+	var departed, arrived Tick
+	for _, e := range capture.Events {
+		switch e.EventType {
+		case DoorsClosed:
+			if e.Floor == 0 {
+				departed = e.Timestamp
+			}
+		case DoorsOpened:
+			if e.Floor == 4 {
+				arrived = e.Timestamp
+			}
+		}
+	}
+	if travel := arrived - departed; travel != 13 {
+		t.Errorf("Expected 4 floors at 2 ticks each with 4 ticks of acceleration and deceleration then a tick to open the doors, took %d", travel)
+	}
+}
+
+type recordingController struct {
+	calls []Direction
+}
+
+func (r *recordingController) Init(elevators []ElevatorID) {}
+func (r *recordingController) Called(floor FloorID, direction Direction) {
+	r.calls = append(r.calls, direction)
+}
+func (r *recordingController) FloorSelected(elevatorID ElevatorID, floor FloorID) {}
+func (r *recordingController) CompletedMove(elevatorID ElevatorID)                {}
+
+func TestHallCallsCarryDirection(t *testing.T) {
+	controller := &recordingController{}
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 2, 0))
+	s.AttachActor(NewActor(3, 2, 0))
+	s.AttachActor(NewActor(0, 2, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+	s.Tick()
+
+	if len(controller.calls) != 2 {
+		t.Fatalf("Expected a call for each lit button, got %v", controller.calls)
+	}
+	if controller.calls[0] != DirectionUp || controller.calls[1] != DirectionDown {
+		t.Errorf("Expected up then down calls, got %v", controller.calls)
+	}
+}
+
+func TestPassingCarLeavesOppositeCallLit(t *testing.T) {
+	controller := &recordingController{}
+	s := NewSimulation()
+	s.AttachActor(NewActor(0, 2, 0, WithBehavior(GoingMyWay(DefaultBehavior{}))))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+	s.EnqueueStops(0, 2, 4)
+
+	for s.status(0).State != DoorsOpen {
+		s.Tick()
+	}
+	if !s.floors[2].lit(DirectionDown) {
+		t.Errorf("Expected the down call to remain lit for the car headed up")
+	}
+	for s.status(0).Floor == 2 {
+		s.Tick()
+	}
+	if len(controller.calls) != 2 || controller.calls[1] != DirectionDown {
+		t.Errorf("Expected the unanswered down call to be announced again as the car departed, got %v", controller.calls)
+	}
+}
+
+type deferralRecordingController struct {
+	recordingController
+	deferred []FloorID
+}
+
+func (d *deferralRecordingController) MoveDeferred(elevatorID ElevatorID, floor FloorID) {
+	d.deferred = append(d.deferred, floor)
+}
+
+func TestMovingElevatorIsRedirected(t *testing.T) {
+	capture := NewEventLog()
+	controller := &deferralRecordingController{}
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 10)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+
+	s.MoveTo(0, 8)
+	s.Tick()
+	s.Tick()
+	s.MoveTo(0, 4)
+	s.Tick()
+	s.MoveTo(0, 1)
+	for i := 0; i < 20; i++ {
+		s.Tick()
+	}
+
+	var stops []FloorID
+	for _, e := range capture.Events {
+		if e.EventType == DoorsOpened {
+			stops = append(stops, e.Floor)
+		}
+	}
+	if len(stops) != 2 || stops[0] != 4 || stops[1] != 1 {
+		t.Errorf("Expected stops at floor 4 then floor 1, got %v", stops)
+	}
+	if len(controller.deferred) != 1 || controller.deferred[0] != 1 {
+		t.Errorf("Expected the move to floor 1 to be deferred, got %v", controller.deferred)
+	}
+}
+
+func TestMovingElevatorCannotStopWithinDecelerationDistance(t *testing.T) {
+	controller := &deferralRecordingController{}
+	s := NewSimulation()
+	config := DefaultElevatorConfig()
+	config.DecelerationTicks = 2
+	s.InitializeFleet(10, []ElevatorConfig{config})
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+
+	s.MoveTo(0, 8)
+	s.MoveTo(0, 1)
+	if len(controller.deferred) != 1 {
+		t.Errorf("Expected the move to the approaching floor to be deferred, got %v", controller.deferred)
+	}
+	s.MoveTo(0, 2)
+	if len(controller.deferred) != 1 {
+		t.Errorf("Expected the move beyond the approaching floor to be accepted, got %v", controller.deferred)
+	}
+}
+
+func TestSummarizeDurations(t *testing.T) {
+	durations := make([]Tick, 0, 20)
+	for i := Tick(20); i > 0; i-- {
+		durations = append(durations, i)
+	}
+	stat := Summarize(durations)
+	if stat.Count != 20 || stat.Max != 20 || stat.P95 != 19 || stat.Average != 10.5 {
+		t.Errorf("Unexpected summary %#v", stat)
+	}
+}
+
+func TestScoreRecordsActorJourneys(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0))
+	s.AttachActor(NewActor(0, 2, 50))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(30)
+
+	journeys := s.Journeys()
+	if !journeys[0].Completed() {
+		t.Fatalf("Expected first actor to complete, got %#v", journeys[0])
+	}
+	if journeys[0].JourneyTime() != journeys[0].WaitTime()+journeys[0].RideTime() {
+		t.Errorf("Expected journey time to be composed of wait and ride, got %#v", journeys[0])
+	}
+	if journeys[1].Entity != -1 || journeys[1].Boarded() {
+		t.Errorf("Expected second actor to not have started, got %#v", journeys[1])
+	}
+
+	score := s.Score()
+	if score.Actors != 2 || score.Completed != 1 || score.Journey.Max != journeys[0].JourneyTime() {
+		t.Errorf("Unexpected score %s", score)
+	}
+}
+
+func TestElevatorConsumesEnergy(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0))
+	s.Initialize(2, 5)
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(30)
+
+	profile := DefaultEnergyProfile()
+	expected := 4*(profile.PerFloorUp+profile.PerRiderFloorUp) + 2*profile.StartStop
+	if energy, err := s.ElevatorEnergy(0); err != nil || energy != expected {
+		t.Errorf("Expected elevator to consume %.2f, consumed %.2f", expected, energy)
+	}
+	if energy, err := s.ElevatorEnergy(1); err != nil || energy != 0 {
+		t.Errorf("Expected unused elevator to consume nothing, consumed %.2f", energy)
+	}
+	if _, err := s.ElevatorEnergy(2); err == nil {
+		t.Errorf("Expected an error for the energy of an unknown elevator")
+	}
+	if s.Score().Energy != expected {
+		t.Errorf("Expected score to include energy, got %s", s.Score())
+	}
+
+	withoutEnergy := s.Score().Cost(DefaultScoreWeights())
+	weights := DefaultScoreWeights()
+	weights.Energy = 1
+	if withEnergy := s.Score().Cost(weights); withEnergy != withoutEnergy+float64(expected) {
+		t.Errorf("Expected energy to be an optional cost term, got %.2f and %.2f", withoutEnergy, withEnergy)
+	}
+}
+
+func TestEnergyScalesWithLoad(t *testing.T) {
+	energyFor := func(load Load) Energy {
+		s := NewSimulation()
+		s.AttachActor(NewActor(4, 0, 0, WithLoad(load)))
+		s.Initialize(1, 5)
+		s.AttachControllerFunc(NewMoveController)
+		s.TickUpTo(30)
+		energy, err := s.ElevatorEnergy(0)
+		if err != nil {
+			t.Fatalf("Unable to measure energy: %s", err)
+		}
+		return energy
+	}
+
+	profile := DefaultEnergyProfile()
+	expected := 4*(profile.PerFloorUp+4*profile.PerRiderFloorUp) + 2*profile.StartStop
+	if pallet := energyFor(4 * DefaultActorLoad); math.Abs(float64(pallet-expected)) > 1e-9 {
+		t.Errorf("Expected a pallet weighing as much as four people to consume %.2f, consumed %.2f", expected, pallet)
+	}
+	if person := energyFor(DefaultActorLoad); person >= expected {
+		t.Errorf("Expected a person to consume less than the pallet, consumed %.2f", person)
+	}
+}
+
+func TestImpatientActorAbandonsObjective(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 2, 0, WithPatience(3)))
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return &recordingController{}
+	})
+
+	endTick := s.TickUpTo(10)
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected abandoned actor to resolve the simulation")
+	}
+	if endTick != 4 {
+		t.Errorf("Expected actor to abandon after 3 ticks of waiting, simulation ended @ %d", endTick)
+	}
+	abandoned := 0
+	for _, e := range capture.Events {
+		if e.EventType == ActorAbandoned {
+			abandoned++
+		}
+	}
+	if abandoned != 1 {
+		t.Errorf("Expected a single abandonment event, got %d", abandoned)
+	}
+	score := s.Score()
+	if score.Abandoned != 1 || score.Completed != 0 {
+		t.Errorf("Expected abandonment within score, got %s", score)
+	}
+	if cost := score.Cost(DefaultScoreWeights()); cost != DefaultScoreWeights().Abandoned {
+		t.Errorf("Expected abandonment penalty, got %.2f", cost)
+	}
+}
+
+func TestActorTravelsItinerary(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActorWithItinerary(0, 0, []Leg{{Floor: 3, Dwell: 5}, {Floor: 1}}))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(60)
+
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected actor to complete their itinerary")
+	}
+	journeys := s.Journeys()
+	if len(journeys) != 2 {
+		t.Fatalf("Expected a journey per leg, got %#v", journeys)
+	}
+	for i, journey := range journeys {
+		if journey.Leg != i || !journey.Completed() {
+			t.Errorf("Expected leg %d to be completed, got %#v", i, journey)
+		}
+	}
+	if dwelled := journeys[1].CalledAt - journeys[0].ArrivedAt; dwelled != 5 {
+		t.Errorf("Expected actor to dwell 5 ticks between legs, dwelled %d", dwelled)
+	}
+
+	score := s.Score()
+	if score.Actors != 1 || score.Legs != 2 || score.Completed != 2 {
+		t.Errorf("Unexpected score %s", score)
+	}
+}
+
+func TestAbandonedLegAbandonsItinerary(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActorWithItinerary(2, 0, []Leg{{Floor: 4}, {Floor: 0}}, WithPatience(3)))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return &recordingController{}
+	})
+	s.TickUpTo(10)
+
+	score := s.Score()
+	if score.Legs != 2 || score.Abandoned != 2 {
+		t.Errorf("Expected every leg to be abandoned, got %s", score)
+	}
+}
+
+func TestEmptyItineraryFinishesImmediately(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActorWithItinerary(2, 0, nil))
+	s.AttachActor(NewActor(3, 0, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	end := s.TickUpTo(40)
+
+	if !s.ActorsCompletedObjectives() || end >= 40 {
+		t.Errorf("Expected the run to complete without waiting on the actor with nowhere to go, stopped @ %d", end)
+	}
+	if score := s.Score(); score.Actors != 1 || score.Legs != 1 || score.Completed != 1 {
+		t.Errorf("Expected only the travelling actor to be scored, got %s", score)
+	}
+}
+
+func TestLegsToTheCurrentFloorArriveImmediately(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActorWithItinerary(0, 0, []Leg{{Floor: 3, Dwell: 2}, {Floor: 3}, {Floor: 0}}))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	end := s.TickUpTo(60)
+
+	if !s.ActorsCompletedObjectives() || end >= 60 {
+		t.Fatalf("Expected the actor to complete every leg, stopped @ %d", end)
+	}
+	journeys := s.Journeys()
+	if len(journeys) != 3 || journeys[1].Boarded() || !journeys[1].Completed() {
+		t.Errorf("Expected the leg to the floor the actor was on to be arrived at without boarding, got %#v", journeys)
+	}
+}
+
+func TestSnapshotRestoresRunInProgress(t *testing.T) {
+	build := func() *Simulation {
+		s := NewSimulation()
+		s.AttachActor(NewActor(4, 0, 0))
+		s.AttachActor(NewActor(1, 3, 20))
+		s.Initialize(1, 5)
+		s.AttachControllerFunc(NewMoveController)
+		return s
+	}
+
+	reference := build()
+	referenceEnd := reference.TickUpTo(60)
+	if !reference.ActorsCompletedObjectives() {
+		t.Fatalf("Expected reference run to complete")
+	}
+
+	interrupted := build()
+	interrupted.TickUpTo(7)
+	snapshot, err := interrupted.Snapshot()
+	if err != nil {
+		t.Fatalf("Failed to snapshot: %s", err)
+	}
+
+	restored := NewSimulation()
+	if err := restored.Restore(snapshot, NewMoveController); err != nil {
+		t.Fatalf("Failed to restore: %s", err)
+	}
+	if restored.CurrentTick() != 7 {
+		t.Errorf("Expected restored simulation at tick 7, got %d", restored.CurrentTick())
+	}
+	restoredEnd := restored.TickUpTo(60 - 7)
+
+	if restoredEnd != referenceEnd {
+		t.Errorf("Expected restored run to end @ %d, ended @ %d", referenceEnd, restoredEnd)
+	}
+	for i, expected := range reference.Journeys() {
+		if actual := restored.Journeys()[i]; actual != expected {
+			t.Errorf("Journey %d diverged: expected %#v, got %#v", i, expected, actual)
+		}
+	}
+	if reference.Energy() != restored.Energy() {
+		t.Errorf("Expected energy %.2f, got %.2f", reference.Energy(), restored.Energy())
+	}
+}
+
+func TestRestoreRejectsUnknownSnapshotVersion(t *testing.T) {
+	s := NewSimulation()
+	err := s.Restore([]byte(`{"version": 999}`), NewMoveController)
+	var versionError *SnapshotVersionError
+	if !errors.As(err, &versionError) {
+		t.Errorf("Expected a version error, got %v", err)
+	}
+}
+
+func TestSnapshotRefusesActorBehaviors(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0, WithBehavior(GoingMyWay(DefaultBehavior{}))))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(3)
+	if _, err := s.Snapshot(); err == nil {
+		t.Errorf("Expected the snapshot to be refused as the behavior would not be restored")
+	}
+}
+
+func TestRestoreRejectsUnknownReferences(t *testing.T) {
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(3)
+	data, err := s.Snapshot()
+	if err != nil {
+		t.Fatalf("Unable to snapshot: %s", err)
+	}
+
+	for name, damage := range map[string]func(snapshot map[string]any){
+		"elevator place": func(snapshot map[string]any) {
+			entered := snapshot["entered"].([]any)[0].(map[string]any)
+			entered["placeType"], entered["placeIndex"] = PlaceElevator, 3
+		},
+		"leg": func(snapshot map[string]any) {
+			snapshot["actors"].([]any)[0].(map[string]any)["leg"] = 2
+		},
+		"elevator floor": func(snapshot map[string]any) {
+			snapshot["elevators"].([]any)[0].(map[string]any)["currentFloor"] = 9
+		},
+	} {
+		var snapshot map[string]any
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			t.Fatalf("Unable to decode snapshot: %s", err)
+		}
+		damage(snapshot)
+		damaged, err := json.Marshal(snapshot)
+		if err != nil {
+			t.Fatalf("Unable to encode snapshot: %s", err)
+		}
+		if err := NewSimulation().Restore(damaged, NewMoveController); err == nil {
+			t.Errorf("Expected a snapshot with an unknown %s to be rejected", name)
+		}
+	}
+}
+
+// blockingListener holds delivery of each event until released, signaling when it has taken an event.
+type blockingListener struct {
+	taken   chan struct{}
+	release chan struct{}
+	events  []Event
+}
+
+func (b *blockingListener) OnControllerEvent(event Event) {
+	b.taken <- struct{}{}
+	<-b.release
+	b.events = append(b.events, event)
+}
+
+func TestAsynchronousListenerReceivesAllEvents(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachActor(NewActor(1, 0, 0))
+	registration := s.AttachControllerListener(capture, Asynchronously(4, BackpressureBlock))
+	synchronous := NewEventLog()
+	s.AttachControllerListener(synchronous)
+	s.Initialize(1, 2)
+	s.AttachControllerFunc(NewMoveController)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = s.Score()
+				_ = s.CurrentTick()
+			}
+		}
+	}()
+	s.TickUpTo(20)
+	close(stop)
+	<-done
+	registration.Detach()
+
+	if len(capture.Events) != len(synchronous.Events) {
+		t.Fatalf("Expected %d events, got %d", len(synchronous.Events), len(capture.Events))
+	}
+	for i := range capture.Events {
+		if capture.Events[i] != synchronous.Events[i] {
+			t.Errorf("Expected event %d to be %s, got %s", i, synchronous.Events[i].ToString(), capture.Events[i].ToString())
+		}
+	}
+	if registration.Dropped() != 0 {
+		t.Errorf("Expected no dropped events, got %d", registration.Dropped())
+	}
+}
+
+func TestAsynchronousListenerDropsUnderBackpressure(t *testing.T) {
+	for _, example := range []struct {
+		policy   BackpressurePolicy
+		expected []Tick
+	}{
+		{BackpressureDropNewest, []Tick{0, 1}},
+		{BackpressureDropOldest, []Tick{0, 3}},
+	} {
+		s := NewSimulation()
+		listener := &blockingListener{taken: make(chan struct{}, 4), release: make(chan struct{})}
+		registration := s.AttachControllerListener(listener, Asynchronously(1, example.policy))
+
+		// The first event is held by the listener, leaving the single slot of the queue to contend over.
+		s.dispatchControllerEvent(OnTickStart(0))
+		<-listener.taken
+		for i := Tick(1); i < 4; i++ {
+			s.dispatchControllerEvent(OnTickStart(i))
+		}
+		close(listener.release)
+		registration.Detach()
+
+		if registration.Dropped() != 2 {
+			t.Errorf("Expected 2 dropped events, got %d", registration.Dropped())
+		}
+		if len(listener.events) != len(example.expected) {
+			t.Fatalf("Expected %d events, got %d", len(example.expected), len(listener.events))
+		}
+		for i, tick := range example.expected {
+			if listener.events[i].Timestamp != tick {
+				t.Errorf("Expected event %d at tick %d, got %d", i, tick, listener.events[i].Timestamp)
+			}
+		}
+	}
+}
+
+func TestDetachedListenerReceivesNoEvents(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	registration := s.AttachControllerListener(capture)
+	s.Initialize(1, 2)
+	registration.Detach()
+	received := len(capture.Events)
+
+	s.Tick()
+	if len(capture.Events) != received {
+		t.Errorf("Expected no events after detaching, got %d", len(capture.Events)-received)
+	}
+	registration.Detach()
+}
+
+func TestSimulationLogsToAttachedLogger(t *testing.T) {
+	var out bytes.Buffer
+	s := NewSimulation()
+	s.SetLogger(slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug})).With("session", "example"))
+	s.Initialize(1, 2)
+	s.AttachActor(NewActor(1, 0, 0))
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(10)
+
+	var entry struct {
+		Msg     string `json:"msg"`
+		Session string `json:"session"`
+		Tick    Tick   `json:"tick"`
+	}
+	line, _, _ := bytes.Cut(out.Bytes(), []byte("\n"))
+	if err := json.Unmarshal(line, &entry); err != nil {
+		t.Fatalf("Expected structured log entry, got %q: %s", line, err)
+	}
+	if entry.Msg != "moving elevator" || entry.Session != "example" || entry.Tick != 0 {
+		t.Errorf("Unexpected log entry %+v", entry)
+	}
+	if LoggerFor(s) == discardLogger {
+		t.Errorf("Expected controllers to share the simulation's logger")
+	}
+}
+
+// loggingController logs each call through the logger shared by its elevators.
+type loggingController struct {
+	recordingController
+	elevators ControlledElevators
+}
+
+func (l *loggingController) Called(floor FloorID, direction Direction) {
+	LoggerFor(l.elevators).Info("called", "floor", floor)
+	l.elevators.MoveTo(0, floor)
+}
+
+func TestControllersLogWhileTicking(t *testing.T) {
+	var out bytes.Buffer
+	s := NewSimulation()
+	s.SetLogger(slog.New(slog.NewJSONHandler(&out, nil)))
+	s.Initialize(1, 5)
+	s.AttachActor(NewActor(4, 2, 0))
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return &loggingController{elevators: elevators}
+	})
+	s.TickUpTo(3)
+
+	if !bytes.Contains(out.Bytes(), []byte(`"msg":"called"`)) {
+		t.Errorf("Expected the controller to log through the simulation's logger, got %q", out.String())
+	}
+}
+
+// zonedController sends every elevator serving a floor to answer calls there.
+type zonedController struct {
+	elevators ControlledElevators
+	served    ServedFloors
+}
+
+func (z *zonedController) InitZones(served ServedFloors) {
+	z.served = served
+}
+func (z *zonedController) Init(elevators []ElevatorID) {}
+func (z *zonedController) Called(floor FloorID, direction Direction) {
+	for elevator, floors := range z.served {
+		for _, served := range floors {
+			if served == floor {
+				z.elevators.MoveTo(elevator, floor)
+			}
+		}
+	}
+}
+func (z *zonedController) FloorSelected(elevatorID ElevatorID, floor FloorID) {
+	z.elevators.MoveTo(elevatorID, floor)
+}
+func (z *zonedController) CompletedMove(elevatorID ElevatorID) {}
+
+func TestActorTransfersAtSkyLobby(t *testing.T) {
+	lowRise := DefaultElevatorConfig()
+	lowRise.ServedFloors = []FloorID{0, 1, 2, 3}
+	highRise := DefaultElevatorConfig()
+	highRise.ServedFloors = []FloorID{3, 4, 5, 6}
+
+	capture := NewEventLog()
+	controller := &zonedController{}
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.InitializeFleet(7, []ElevatorConfig{lowRise, highRise})
+	s.AttachActor(NewActor(5, 1, 0))
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller.elevators = elevators
+		return controller
+	})
+
+	if floors := controller.served[1]; len(floors) != 4 || floors[0] != 3 {
+		t.Fatalf("Expected high rise to serve floors 3 through 6, got %v", floors)
+	}
+	if s.elevators[1].currentFloor != 3 {
+		t.Errorf("Expected high rise to start at its lowest floor, got %d", s.elevators[1].currentFloor)
+	}
+
+	s.TickUpTo(40)
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected actor to reach floor 5 by transferring")
+	}
+	requests := make([]Event, 0)
+	for _, e := range capture.Events {
+		if e.EventType == ElevatorFloorRequest {
+			requests = append(requests, e)
+		}
+	}
+	if len(requests) != 2 || requests[0].Elevator != 0 || requests[0].Floor != 3 || requests[1].Elevator != 1 || requests[1].Floor != 5 {
+		t.Errorf("Expected a ride to the sky lobby followed by the high rise, got %v", requests)
+	}
+}
+
+// mistakenController answers the first call with the low rise car, which does not serve the actor's goal, before
+// answering with the car serving every floor.
+type mistakenController struct {
+	recordingController
+	elevators ControlledElevators
+}
+
+func (m *mistakenController) Called(floor FloorID, direction Direction) {
+	m.recordingController.Called(floor, direction)
+	if len(m.calls) == 1 {
+		m.elevators.MoveTo(0, floor)
+	} else {
+		m.elevators.MoveTo(1, floor)
+	}
+}
+func (m *mistakenController) FloorSelected(elevatorID ElevatorID, floor FloorID) {
+	m.elevators.MoveTo(elevatorID, floor)
+}
+
+func TestActorCallsAgainAfterUnservingCarDeparts(t *testing.T) {
+	lowRise := DefaultElevatorConfig()
+	lowRise.ServedFloors = []FloorID{0, 1, 2}
+
+	controller := &mistakenController{}
+	s := NewSimulation()
+	s.InitializeFleet(5, []ElevatorConfig{lowRise, DefaultElevatorConfig()})
+	s.AttachActor(NewActor(4, 1, 0))
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller.elevators = elevators
+		return controller
+	})
+
+	s.TickUpTo(60)
+	if len(controller.calls) != 2 {
+		t.Errorf("Expected the actor to call again once the low rise departed, got %v", controller.calls)
+	}
+	if !s.ActorsCompletedObjectives() {
+		t.Errorf("Expected the actor to reach floor 4")
+	}
+}
+
+func TestMoveToUnservedFloorIsRejected(t *testing.T) {
+	express := DefaultElevatorConfig()
+	express.ServedFloors = []FloorID{0, 4}
+
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.InitializeFleet(5, []ElevatorConfig{express})
+	s.MoveTo(0, 2)
+	s.Tick()
+
+	rejected := false
+	for _, e := range capture.Events {
+		rejected = rejected || (e.EventType == ElevatorMoveRejected && e.Elevator == 0 && e.Floor == 2)
+	}
+	if !rejected {
+		t.Errorf("Expected move to floor 2 to be rejected")
+	}
+	if s.elevators[0].state != Idle {
+		t.Errorf("Expected elevator to remain idle, got state %d", s.elevators[0].state)
+	}
+}
+
+func TestEventsCarryFloorLabels(t *testing.T) {
+	building := Building{Floors: append(append(Basements(2), "L"), append(FloorRange(1, 2), "P")...), Lobby: 2}
+	if err := building.Validate(); err != nil {
+		t.Fatalf("Expected building to be valid, got %s", err)
+	}
+	if building.Level(building.MustFloor("B2")) != -2 || building.Level(building.MustFloor("P")) != 3 {
+		t.Errorf("Expected levels relative to the lobby, got B2 %d and P %d", building.Level(0), building.Level(5))
+	}
+
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.InitializeBuilding(building, []ElevatorConfig{DefaultElevatorConfig()})
+	s.AttachActor(NewActor(int(building.MustFloor("P")), int(building.MustFloor("B1")), 0))
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(30)
+
+	described := make(map[string]bool)
+	for _, e := range capture.Events {
+		described[e.ToString()] = true
+	}
+	for _, expected := range []string{"Event{InformFloor, B2}", "Event{ElevatorCall, B1 going up by actor 0}", "Event{ElevatorFloorRequest, 0 @ P by actor 0}"} {
+		if !described[expected] {
+			t.Errorf("Expected event %s", expected)
+		}
+	}
+
+	data, err := s.Snapshot()
+	if err != nil {
+		t.Fatalf("Unable to snapshot: %s", err)
+	}
+	restored := NewSimulation()
+	if err := restored.Restore(data, NewMoveController); err != nil {
+		t.Fatalf("Unable to restore: %s", err)
+	}
+	if restored.Building().Label(2) != "L" || restored.Building().Lobby != 2 {
+		t.Errorf("Expected building to be restored, got %+v", restored.Building())
+	}
+}
+
+// faultRecordingController captures fault notifications and rejected moves.
+type faultRecordingController struct {
+	recordingController
+	outOfService []FaultKind
+	restored     []ElevatorID
+	rejections   []error
+}
+
+func (f *faultRecordingController) OutOfService(elevatorID ElevatorID, fault FaultKind) {
+	f.outOfService = append(f.outOfService, fault)
+}
+func (f *faultRecordingController) Restored(elevatorID ElevatorID) {
+	f.restored = append(f.restored, elevatorID)
+}
+func (f *faultRecordingController) MoveRejected(elevatorID ElevatorID, floor FloorID, err error) {
+	f.rejections = append(f.rejections, err)
+}
+
+func TestFaultTrapsRidersUntilRestored(t *testing.T) {
+	controller := &faultRecordingController{}
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+	if err := s.ScheduleFault(Fault{Elevator: 0, Kind: FaultDoors, At: 3, Duration: 4}); err != nil {
+		t.Fatalf("Unable to schedule fault: %s", err)
+	}
+
+	// Open the doors at the lobby and board a rider before the fault.
+	s.MoveTo(0, 0)
+	s.Tick()
+	rider := s.StartAt(NewActor(4, 0, 0), 0)
+	if !s.Enter(rider, 0) {
+		t.Fatalf("Expected rider to board")
+	}
+	s.MoveTo(0, 4)
+	for s.CurrentTick() < 4 {
+		s.Tick()
+	}
+	floor := s.elevators[0].currentFloor
+	state := s.elevators[0].state
+
+	s.MoveTo(0, 2)
+	var outOfService *OutOfServiceError
+	if len(controller.rejections) != 1 || !errors.As(controller.rejections[0], &outOfService) || outOfService.Fault != FaultDoors {
+		t.Fatalf("Expected move to be rejected as out of service, got %v", controller.rejections)
+	}
+	if len(controller.outOfService) != 1 || controller.outOfService[0] != FaultDoors {
+		t.Errorf("Expected controller to learn of the door fault, got %v", controller.outOfService)
+	}
+
+	for s.CurrentTick() < 7 {
+		s.Tick()
+		if s.elevators[0].currentFloor != floor || s.elevators[0].state != state {
+			t.Fatalf("Expected elevator to be frozen at tick %d", s.CurrentTick())
+		}
+		if s.enteredActors[rider].placeType != PlaceElevator {
+			t.Fatalf("Expected rider to remain trapped at tick %d", s.CurrentTick())
+		}
+	}
+	s.Tick()
+	if len(controller.restored) != 1 {
+		t.Fatalf("Expected elevator to be restored, got %v", controller.restored)
+	}
+
+	var sawOut, sawRestored bool
+	for _, e := range capture.Events {
+		sawOut = sawOut || (e.EventType == ElevatorOutOfService && e.Fault == FaultDoors)
+		sawRestored = sawRestored || e.EventType == ElevatorRestored
+	}
+	if !sawOut || !sawRestored {
+		t.Errorf("Expected out of service and restored events")
+	}
+}
+
+func TestOverlappingFaultsRestoreOnceBothEnd(t *testing.T) {
+	controller := &faultRecordingController{}
+	s := NewSimulation()
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+	if err := s.ScheduleFault(Fault{Elevator: 0, Kind: FaultBreakdown, At: 1, Duration: 4}); err != nil {
+		t.Fatalf("Unable to schedule fault: %s", err)
+	}
+	if err := s.ScheduleFault(Fault{Elevator: 0, Kind: FaultMaintenance, At: 3, Duration: 5}); err != nil {
+		t.Fatalf("Unable to schedule fault: %s", err)
+	}
+
+	for s.CurrentTick() < 6 {
+		s.Tick()
+	}
+	if len(controller.restored) != 0 || s.status(0).Fault == FaultNone {
+		t.Errorf("Expected the elevator to remain out of service for maintenance, restored %v", controller.restored)
+	}
+	for s.CurrentTick() < 9 {
+		s.Tick()
+	}
+	if len(controller.restored) != 1 || s.status(0).Fault != FaultNone {
+		t.Errorf("Expected the elevator to be restored once maintenance ended, restored %v", controller.restored)
+	}
+}
+
+func TestFaultForUnknownElevatorIsRejected(t *testing.T) {
+	s := NewSimulation()
+	s.Initialize(1, 5)
+	if err := s.ScheduleFault(Fault{Elevator: 1, Kind: FaultBreakdown, At: 1}); err == nil {
+		t.Errorf("Expected a fault for an unknown elevator to be rejected")
+	}
+	s.AttachControllerFunc(NewMoveController)
+	for s.CurrentTick() < 3 {
+		s.Tick()
+	}
+}
+
+func TestStatusDescribesElevator(t *testing.T) {
+	s := NewSimulation()
+	s.Initialize(2, 6)
+	s.AttachControllerFunc(NewMoveController)
+	s.MoveTo(1, 4)
+	s.Tick()
+
+	status := s.status(1)
+	if status.Direction != DirectionUp || status.State != MovingUp || status.Target != 4 || status.Floor != 1 {
+		t.Errorf("Expected elevator 1 travelling up towards 4, got %+v", status)
+	}
+	if idle := s.Statuses()[0]; idle.Direction != DirectionNone || idle.Target != 0 || idle.Capacity != DefaultElevatorCapacity {
+		t.Errorf("Expected elevator 0 idle at the lobby, got %+v", idle)
+	}
+}
+
+// tickingController parks its elevator at the top floor once the given tick starts.
+type tickingController struct {
+	recordingController
+	elevators ControlledElevators
+	ticks     []Tick
+	parkAt    Tick
+}
+
+func (p *tickingController) Tick(tick Tick) {
+	p.ticks = append(p.ticks, tick)
+	if tick == p.parkAt {
+		p.elevators.MoveTo(0, 4)
+	}
+}
+
+func TestTickObserverIsGivenEachTick(t *testing.T) {
+	var controller *tickingController
+	s := NewSimulation()
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller = &tickingController{elevators: elevators, parkAt: 2}
+		return controller
+	})
+	for i := 0; i < 3; i++ {
+		s.Tick()
+	}
+
+	if len(controller.ticks) != 3 || controller.ticks[0] != 0 || controller.ticks[2] != 2 {
+		t.Fatalf("Expected ticks 0 through 2, got %v", controller.ticks)
+	}
+	if status := s.status(0); status.Target != 4 || status.State != MovingUp {
+		t.Errorf("Expected elevator to start moving within the tick it was directed, got %+v", status)
+	}
+}
+
+func servedStops(events []Event) []FloorID {
+	var stops []FloorID
+	for _, e := range events {
+		if e.EventType == ElevatorStopServed {
+			stops = append(stops, e.Floor)
+		}
+	}
+	return stops
+}
+
+func TestElevatorFollowsItinerary(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 8)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return &recordingController{}
+	})
+
+	s.EnqueueStops(0, 3, 5, 1)
+	if plan := s.status(0).Itinerary; len(plan) != 3 || plan[0] != 3 || plan[2] != 1 {
+		t.Fatalf("Expected the itinerary to be read back, got %v", plan)
+	}
+	for i := 0; i < 40 && (len(s.status(0).Itinerary) > 0 || s.elevators[0].state != Idle); i++ {
+		s.Tick()
+	}
+
+	if stops := servedStops(capture.Events); len(stops) != 3 || stops[0] != 3 || stops[1] != 5 || stops[2] != 1 {
+		t.Fatalf("Expected stops at 3, 5 then 1, got %v", stops)
+	}
+	opened := 0
+	for _, e := range capture.Events {
+		if e.EventType == DoorsOpened {
+			opened++
+		}
+	}
+	if opened != 3 {
+		t.Errorf("Expected the doors to cycle at each stop, opened %d times", opened)
+	}
+}
+
+func TestInsertedStopIsServedFirst(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 8)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return &recordingController{}
+	})
+
+	s.EnqueueStops(0, 6)
+	s.Tick()
+	s.InsertStop(0, 0, 2)
+	if status := s.status(0); status.Target != 2 {
+		t.Fatalf("Expected the car to be redirected to the inserted stop, got %+v", status)
+	}
+	for i := 0; i < 30 && len(s.status(0).Itinerary) > 0; i++ {
+		s.Tick()
+	}
+	if stops := servedStops(capture.Events); len(stops) != 2 || stops[0] != 2 || stops[1] != 6 {
+		t.Errorf("Expected stops at 2 then 6, got %v", stops)
+	}
+}
+
+func TestClearedStopsAreNotServed(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 8)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return &recordingController{}
+	})
+
+	s.EnqueueStops(0, 4, 6)
+	s.Tick()
+	s.ClearStops(0)
+	for i := 0; i < 30; i++ {
+		s.Tick()
+	}
+	if stops := servedStops(capture.Events); len(stops) != 0 {
+		t.Errorf("Expected no stops to be served once cleared, got %v", stops)
+	}
+	if floor := s.status(0).Floor; floor != 4 {
+		t.Errorf("Expected the car to complete its run to 4, stopped at %d", floor)
+	}
+}
+
+func TestEventsAreUniformlyPopulated(t *testing.T) {
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 5)
+	s.AttachActor(NewActor(3, 0, 1))
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(20)
+
+	current := Tick(0)
+	order := make(map[EventType]int)
+	for i, e := range capture.Events {
+		if e.EventType == TickStart {
+			current = e.Timestamp
+		}
+		if e.Timestamp != current {
+			t.Errorf("Expected %s to be stamped with tick %d", e.ToString(), current)
+		}
+		switch e.EventType {
+		case ActorSpawned, ElevatorCalled, ActorBoarded, ElevatorFloorRequest, ElevatorArrived, ActorExited, ActorFinished:
+			if e.Entity != 0 {
+				t.Errorf("Expected %s to concern actor 0", e.ToString())
+			}
+			if _, seen := order[e.EventType]; !seen {
+				order[e.EventType] = i
+			}
+		default:
+			if e.Entity != NoEntity {
+				t.Errorf("Expected %s to concern no actor", e.ToString())
+			}
+		}
+	}
+	sequence := []EventType{ActorSpawned, ElevatorCalled, ActorBoarded, ElevatorFloorRequest, ElevatorArrived, ActorExited, ActorFinished}
+	for i, eventType := range sequence {
+		if _, seen := order[eventType]; !seen {
+			t.Fatalf("Expected an event of type %d", eventType)
+		}
+		if i > 0 && order[sequence[i-1]] > order[eventType] {
+			t.Errorf("Expected event type %d to follow %d", eventType, sequence[i-1])
+		}
+	}
+}
+
+func TestActorTakesStairsForShortTrips(t *testing.T) {
+	capture := NewEventLog()
+	controller := &recordingController{}
+	s := NewSimulation()
+	s.AttachActor(NewActor(1, 0, 0, WithBehavior(TakesStairs(1, 3, DefaultBehavior{}))))
+	s.AttachControllerListener(capture)
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		return controller
+	})
+	s.TickUpTo(10)
+
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected actor to walk to their goal")
+	}
+	if len(controller.calls) != 0 {
+		t.Errorf("Expected no elevator to be called, got %v", controller.calls)
+	}
+	if ride := s.Journeys()[0].RideTime(); ride != 3 {
+		t.Errorf("Expected the climb to take 3 ticks, took %d", ride)
+	}
+	walked := false
+	for _, e := range capture.Events {
+		walked = walked || (e.EventType == ActorTookStairs && e.Floor == 1)
+	}
+	if !walked {
+		t.Errorf("Expected an event for the actor taking the stairs")
+	}
+}
+
+type selectionCountingController struct {
+	MoveController
+	selections int
+}
+
+func (c *selectionCountingController) FloorSelected(elevatorID ElevatorID, floor FloorID) {
+	c.selections++
+	c.MoveController.FloorSelected(elevatorID, floor)
+}
+
+func TestActorPressesAgainWhileRiding(t *testing.T) {
+	controller := &selectionCountingController{}
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0, WithBehavior(Repressing(2, DefaultBehavior{}))))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller.MoveController = MoveController{simulation: elevators, elevatorID: -1}
+		return controller
+	})
+	s.TickUpTo(30)
+
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected actor to complete their objective")
+	}
+	if controller.selections < 2 {
+		t.Errorf("Expected actor to press their floor again while riding, pressed %d times", controller.selections)
+	}
+}
+
+func TestGoingMyWayPassesOverCarsHeadingAway(t *testing.T) {
+	behavior := GoingMyWay(DefaultBehavior{})
+	actor := ActorStatus{Floor: 2, Goal: 0, LegGoal: 0}
+	if behavior.Boards(actor, ElevatorStatus{Floor: 2, Target: 2, CarCalls: []FloorID{4}}) {
+		t.Errorf("Expected actor going down to pass over a car heading up")
+	}
+	if !behavior.Boards(actor, ElevatorStatus{Floor: 2, Target: 2, CarCalls: []FloorID{1}}) {
+		t.Errorf("Expected actor going down to board a car heading down")
+	}
+	if !behavior.Boards(actor, ElevatorStatus{Floor: 2, Target: 2}) {
+		t.Errorf("Expected actor to board a car with nowhere to go")
+	}
+}
+
+func TestGroupBoardsTogether(t *testing.T) {
+	group := Group(2, DefaultBehavior{})
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0, WithBehavior(group)))
+	s.AttachActor(NewActor(4, 0, 4, WithBehavior(group)))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+	s.TickUpTo(40)
+
+	if !s.ActorsCompletedObjectives() {
+		t.Fatalf("Expected the group to complete their objective")
+	}
+	journeys := s.Journeys()
+	if journeys[0].BoardedAt < 4 {
+		t.Errorf("Expected the first member to wait for the second, boarded @ %d", journeys[0].BoardedAt)
+	}
+	if journeys[0].ArrivedAt != journeys[1].ArrivedAt {
+		t.Errorf("Expected the group to arrive together, got %#v", journeys)
+	}
+}
+
+func TestGroupMemberLeftBehindTravelsAlone(t *testing.T) {
+	group := Group(2, DefaultBehavior{})
+	config := DefaultElevatorConfig()
+	config.Capacity = 2
+	s := NewSimulation()
+	s.AttachActor(NewActor(4, 0, 0, WithBehavior(group)))
+	s.AttachActor(NewActor(4, 0, 0))
+	s.AttachActor(NewActor(4, 0, 5))
+	s.AttachActor(NewActor(4, 0, 0, WithBehavior(group)))
+	s.InitializeFleet(5, []ElevatorConfig{config})
+	s.AttachControllerFunc(NewMoveController)
+	end := s.TickUpTo(120)
+
+	if !s.ActorsCompletedObjectives() {
+		t.Errorf("Expected the member turned away by the full car to follow on their own, stopped @ %d", end)
+	}
+}
+
+type faultingController struct {
+	recordingController
+	elevators ControlledElevators
+	faults    []error
+	returned  []error
+}
+
+func (f *faultingController) Called(floor FloorID, direction Direction) {
+	f.returned = append(f.returned, f.elevators.MoveTo(7, floor))
+	f.returned = append(f.returned, f.elevators.EnqueueStops(0, floor, 99))
+}
+
+func (f *faultingController) ControllerFault(err error) {
+	f.faults = append(f.faults, err)
+}
+
+func runFaultingController(policy ControllerFaultPolicy) (*Simulation, *faultingController, *EventLog) {
+	controller := &faultingController{}
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.SetControllerFaultPolicy(policy)
+	s.AttachControllerListener(capture)
+	s.AttachActor(NewActor(4, 2, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller.elevators = elevators
+		return controller
+	})
+	s.TickUpTo(5)
+	return s, controller, capture
+}
+
+func TestInvalidCommandsAreControllerFaults(t *testing.T) {
+	s, controller, capture := runFaultingController(PenalizeControllerFaults)
+
+	if len(controller.faults) != 2 {
+		t.Fatalf("Expected a fault for each invalid command, got %v", controller.faults)
+	}
+	var fault *ControllerFaultError
+	if !errors.As(controller.faults[0], &fault) || fault.Command != "MoveTo" || fault.Elevator != 7 {
+		t.Errorf("Expected a fault for moving an unknown elevator, got %v", controller.faults[0])
+	}
+	if !errors.As(controller.faults[1], &fault) || fault.Command != "EnqueueStops" || fault.Floor != 99 {
+		t.Errorf("Expected a fault for enqueuing an unknown floor, got %v", controller.faults[1])
+	}
+	if len(controller.returned) != 2 || controller.returned[0] != controller.faults[0] || controller.returned[1] != controller.faults[1] {
+		t.Errorf("Expected each command to return its fault, got %v", controller.returned)
+	}
+	if itinerary := s.status(0).Itinerary; len(itinerary) != 0 {
+		t.Errorf("Expected none of the invalid stops to be enqueued, got %v", itinerary)
+	}
+	faults := 0
+	for _, e := range capture.Events {
+		if e.EventType == ControllerFault {
+			faults++
+		}
+	}
+	if faults != 2 {
+		t.Errorf("Expected an event for each fault, got %d", faults)
+	}
+	score := s.Score()
+	if score.ControllerFaults != 2 || score.Cost(DefaultScoreWeights()) < 2*DefaultScoreWeights().ControllerFault {
+		t.Errorf("Expected faults to be penalized, got %s", score)
+	}
+}
+
+func TestIgnoredControllerFaultsAreNotPenalized(t *testing.T) {
+	s, controller, _ := runFaultingController(IgnoreControllerFaults)
+
+	if len(controller.faults) != 2 {
+		t.Errorf("Expected ignored faults to be reported, got %v", controller.faults)
+	}
+	if score := s.Score(); score.ControllerFaults != 0 {
+		t.Errorf("Expected ignored faults to not count against the controller, got %s", score)
+	}
+}
+
+func TestControllerFaultDisqualifiesController(t *testing.T) {
+	s, controller, _ := runFaultingController(DisqualifyControllerFaults)
+
+	if len(controller.faults) != 1 {
+		t.Errorf("Expected commands after disqualification to be dropped, got %v", controller.faults)
+	}
+	if s.Disqualification() == nil {
+		t.Fatalf("Expected the controller to be disqualified")
+	}
+	if len(controller.returned) != 2 || controller.returned[1] != s.Disqualification() {
+		t.Errorf("Expected commands after disqualification to return the disqualifying fault, got %v", controller.returned)
+	}
+	if s.CurrentTick() != 1 || s.Tick() {
+		t.Errorf("Expected the simulation to end upon disqualification, @ %d", s.CurrentTick())
+	}
+	score := s.Score()
+	if !score.Disqualified || !math.IsInf(score.Cost(DefaultScoreWeights()), 1) {
+		t.Errorf("Expected a disqualified score, got %s", score)
+	}
+}
+
+type queryingController struct {
+	recordingController
+	elevators ControlledElevators
+	errors    []error
+}
+
+func (q *queryingController) Called(floor FloorID, direction Direction) {
+	if _, err := q.elevators.Status(7); err != nil {
+		q.errors = append(q.errors, err)
+	}
+	if _, err := q.elevators.Itinerary(-1); err != nil {
+		q.errors = append(q.errors, err)
+	}
+	q.elevators.MoveTo(0, floor)
+}
+
+func TestQueriesOfUnknownElevatorsAreNotFaults(t *testing.T) {
+	controller := &queryingController{}
+	s := NewSimulation()
+	s.SetControllerFaultPolicy(DisqualifyControllerFaults)
+	s.AttachActor(NewActor(4, 2, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller.elevators = elevators
+		return controller
+	})
+	s.TickUpTo(5)
+
+	var fault *ControllerFaultError
+	if len(controller.errors) != 2 || !errors.As(controller.errors[0], &fault) || fault.Command != "Status" || fault.Elevator != 7 {
+		t.Fatalf("Expected an error for each query of an unknown elevator, got %v", controller.errors)
+	}
+	if s.Disqualification() != nil || s.Score().ControllerFaults != 0 {
+		t.Errorf("Expected queries to not count against the controller, got %s", s.Score())
+	}
+}
+
+type reportingController struct {
+	faultingController
+}
+
+func (r *reportingController) Called(floor FloorID, direction Direction) {
+	r.elevators.(ControllerFaultReporter).ReportControllerFault(&ControllerFaultError{
+		Command:  "Notice",
+		Elevator: NoElevator,
+		Floor:    NoFloor,
+		Reason:   "remote controller unavailable",
+	})
+}
+
+func TestReportedControllerFaultsDisqualifyController(t *testing.T) {
+	controller := &reportingController{}
+	capture := NewEventLog()
+	s := NewSimulation()
+	s.SetControllerFaultPolicy(DisqualifyControllerFaults)
+	s.AttachControllerListener(capture)
+	s.AttachActor(NewActor(4, 2, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(func(elevators ControlledElevators) Controller {
+		controller.elevators = elevators
+		return controller
+	})
+	s.TickUpTo(5)
+
+	if s.Disqualification() == nil || s.Score().ControllerFaults != 1 {
+		t.Fatalf("Expected the reported fault to disqualify the controller, got %s", s.Score())
+	}
+	if len(controller.faults) != 0 {
+		t.Errorf("Expected the controller to not be told of the fault it reported, got %v", controller.faults)
+	}
+	described := ""
+	for _, e := range capture.Events {
+		if e.EventType == ControllerFault {
+			described = e.ToString()
+		}
+	}
+	if described != "Event{ControllerFault}" {
+		t.Errorf("Expected the fault to be recorded without an elevator or floor, got %q", described)
+	}
+}
+
+// queryingListener queries the simulation as each event is delivered.
+type queryingListener struct {
+	simulation *Simulation
+	ticks      []Tick
+}
+
+func (q *queryingListener) OnControllerEvent(event Event) {
+	q.ticks = append(q.ticks, q.simulation.CurrentTick())
+}
+
+func TestBlockingAsynchronousListenerMayQuerySimulation(t *testing.T) {
+	s := NewSimulation()
+	listener := &queryingListener{simulation: s}
+	registration := s.AttachControllerListener(listener, Asynchronously(1, BackpressureBlock))
+	s.AttachActor(NewActor(4, 0, 0))
+	s.Initialize(1, 5)
+	s.AttachControllerFunc(NewMoveController)
+
+	done := make(chan Tick)
+	go func() {
+		done <- s.TickUpTo(50)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the simulation to proceed while the listener queries it")
+	}
+	registration.Detach()
+	if len(listener.ticks) == 0 {
+		t.Errorf("Expected the listener to receive events")
+	}
+}
@@ -0,0 +1,324 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SnapshotVersion identifies the format produced by Simulation.Snapshot.  Restore only accepts snapshots of this version.
+const SnapshotVersion = 3
+
+// SnapshotVersionError is produced when restoring a snapshot written in an unsupported format.
+type SnapshotVersionError struct {
+	Version int
+}
+
+func (s *SnapshotVersionError) Error() string {
+	return fmt.Sprintf("unsupported snapshot version %d, expected %d", s.Version, SnapshotVersion)
+}
+
+type simulationSnapshot struct {
+	Version   int                `json:"version"`
+	Tick      Tick               `json:"tick"`
+	Building  Building           `json:"building"`
+	Faults    []Fault            `json:"faults"`
+	Elevators []elevatorSnapshot `json:"elevators"`
+	Floors    []floorSnapshot    `json:"floors"`
+	Actors    []actorSnapshot    `json:"actors"`
+	Entered   []enteredSnapshot  `json:"entered"`
+
+	ControllerFaultPolicy ControllerFaultPolicy `json:"controllerFaultPolicy"`
+	ControllerFaults      int                   `json:"controllerFaults"`
+	Disqualification      *ControllerFaultError `json:"disqualification,omitempty"`
+}
+
+type elevatorSnapshot struct {
+	Config        ElevatorConfig `json:"config"`
+	State         int            `json:"state"`
+	MoveToFloor   int            `json:"moveToFloor"`
+	CurrentFloor  int            `json:"currentFloor"`
+	DesiredFloors []int          `json:"desiredFloors"`
+	PhaseTicks    int            `json:"phaseTicks"`
+	TravelTicks   int            `json:"travelTicks"`
+	Energy        Energy         `json:"energy"`
+	PendingMove   bool           `json:"pendingMove"`
+	PendingFloor  int            `json:"pendingFloor"`
+	Fault         FaultKind      `json:"fault"`
+	Itinerary     []int          `json:"itinerary"`
+}
+
+type floorSnapshot struct {
+	UpCalled   bool `json:"upCalled"`
+	DownCalled bool `json:"downCalled"`
+}
+
+type actorSnapshot struct {
+	FloorGoal         int   `json:"floorGoal"`
+	StartingFloor     int   `json:"startingFloor"`
+	StartingTick      Tick  `json:"startingTick"`
+	CalledTick        Tick  `json:"calledTick"`
+	BoardedTick       Tick  `json:"boardedTick"`
+	CompletedGoalTick Tick  `json:"completedGoalTick"`
+	AbandonedTick     Tick  `json:"abandonedTick"`
+	Patience          Tick  `json:"patience"`
+	WaitingSince      Tick  `json:"waitingSince"`
+	LegGoal           int   `json:"legGoal"`
+	State             int   `json:"state"`
+	ActorID           int   `json:"actorID"`
+	RefusedBy         []int `json:"refusedBy"`
+	// Legs is empty for actors given an empty itinerary, who finish without ever starting.
+	Legs          []Leg     `json:"legs"`
+	Leg           int       `json:"leg"`
+	CompletedLegs []Journey `json:"completedLegs"`
+	DwellUntil    Tick      `json:"dwellUntil"`
+	PassedOver    []int     `json:"passedOver"`
+	WalkingUntil  Tick      `json:"walkingUntil"`
+	Load          Load      `json:"load"`
+}
+
+type enteredSnapshot struct {
+	PlaceType  int `json:"placeType"`
+	PlaceIndex int `json:"placeIndex"`
+	// Actor is the index of the actor within the snapshot's actors.
+	Actor int `json:"actor"`
+}
+
+// Snapshot serializes the complete state of the simulation as JSON.  Neither the controller nor attached listeners are
+// included within the snapshot.  The behaviors of actors are not captured either, so simulations with actors following
+// anything other than DefaultBehavior are refused rather than diverging once restored.
+func (s *Simulation) Snapshot() ([]byte, error) {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	for i, a := range s.actors {
+		if _, ok := a.behavior.(DefaultBehavior); !ok {
+			return nil, fmt.Errorf("unable to snapshot actor %d: behavior %T is not captured within snapshots", i, a.behavior)
+		}
+	}
+
+	out := simulationSnapshot{
+		Version:   SnapshotVersion,
+		Tick:      s.tick,
+		Building:  s.building,
+		Faults:    s.faults,
+		Elevators: make([]elevatorSnapshot, len(s.elevators)),
+		Floors:    make([]floorSnapshot, len(s.floors)),
+		Actors:    make([]actorSnapshot, len(s.actors)),
+		Entered:   make([]enteredSnapshot, len(s.enteredActors)),
+
+		ControllerFaultPolicy: s.controllerFaultPolicy,
+		ControllerFaults:      s.controllerFaults,
+		Disqualification:      s.disqualification,
+	}
+	for i, e := range s.elevators {
+		out.Elevators[i] = elevatorSnapshot{
+			Config:        e.config,
+			State:         e.state,
+			MoveToFloor:   e.moveToFloor,
+			CurrentFloor:  e.currentFloor,
+			DesiredFloors: e.desiredFloors,
+			PhaseTicks:    e.phaseTicks,
+			TravelTicks:   e.travelTicks,
+			Energy:        e.energy,
+			PendingMove:   e.pendingMove,
+			PendingFloor:  e.pendingFloor,
+			Itinerary:     append([]int(nil), e.itinerary...),
+			Fault:         e.fault,
+		}
+	}
+	for i, f := range s.floors {
+		out.Floors[i] = floorSnapshot{UpCalled: f.upCalled, DownCalled: f.downCalled}
+	}
+	actorIndex := make(map[*Actor]int, len(s.actors))
+	for i, a := range s.actors {
+		actorIndex[a] = i
+		out.Actors[i] = actorSnapshot{
+			FloorGoal:         a.floorGoal,
+			StartingFloor:     a.startingFloor,
+			StartingTick:      a.startingTick,
+			CalledTick:        a.calledTick,
+			BoardedTick:       a.boardedTick,
+			CompletedGoalTick: a.completedGoalTick,
+			AbandonedTick:     a.abandonedTick,
+			Patience:          a.patience,
+			WaitingSince:      a.waitingSince,
+			LegGoal:           a.legGoal,
+			State:             a.state,
+			ActorID:           a.actorID,
+			RefusedBy:         a.refusedBy,
+			Legs:              a.legs,
+			Leg:               a.leg,
+			CompletedLegs:     a.completedLegs,
+			DwellUntil:        a.dwellUntil,
+			PassedOver:        a.passedOver,
+			WalkingUntil:      a.walkingUntil,
+			Load:              a.load,
+		}
+	}
+	for i, entered := range s.enteredActors {
+		out.Entered[i] = enteredSnapshot{
+			PlaceType:  entered.placeType,
+			PlaceIndex: entered.placeIndex,
+			Actor:      actorIndex[entered.actor],
+		}
+	}
+	return json.Marshal(out)
+}
+
+// Restore replaces the state of the simulation with the given snapshot.  As controller state is not captured within a
+// snapshot, a fresh controller is produced by the factory and initialized against the restored elevators.  Attached
+// listeners are retained and do not receive initialization events.
+func (s *Simulation) Restore(data []byte, factory ControllerFunc) error {
+	var in simulationSnapshot
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if in.Version != SnapshotVersion {
+		return &SnapshotVersionError{Version: in.Version}
+	}
+	if err := in.validate(); err != nil {
+		return err
+	}
+
+	elevators := make([]*Elevator, len(in.Elevators))
+	for i, e := range in.Elevators {
+		elevator := NewConfiguredElevator(e.Config)
+		elevator.state = e.State
+		elevator.moveToFloor = e.MoveToFloor
+		elevator.currentFloor = e.CurrentFloor
+		elevator.desiredFloors = append(elevator.desiredFloors, e.DesiredFloors...)
+		elevator.phaseTicks = e.PhaseTicks
+		elevator.travelTicks = e.TravelTicks
+		elevator.energy = e.Energy
+		elevator.pendingMove = e.PendingMove
+		elevator.pendingFloor = e.PendingFloor
+		elevator.fault = e.Fault
+		elevator.itinerary = append(elevator.itinerary, e.Itinerary...)
+		elevators[i] = elevator
+	}
+	floors := make([]*Floor, len(in.Floors))
+	for i, f := range in.Floors {
+		floors[i] = &Floor{upCalled: f.UpCalled, downCalled: f.DownCalled}
+	}
+	actors := make([]*Actor, len(in.Actors))
+	for i, a := range in.Actors {
+		actors[i] = &Actor{
+			floorGoal:         a.FloorGoal,
+			startingFloor:     a.StartingFloor,
+			startingTick:      a.StartingTick,
+			calledTick:        a.CalledTick,
+			boardedTick:       a.BoardedTick,
+			completedGoalTick: a.CompletedGoalTick,
+			abandonedTick:     a.AbandonedTick,
+			patience:          a.Patience,
+			waitingSince:      a.WaitingSince,
+			legGoal:           a.LegGoal,
+			state:             a.State,
+			actorID:           a.ActorID,
+			refusedBy:         a.RefusedBy,
+			legs:              a.Legs,
+			leg:               a.Leg,
+			completedLegs:     a.CompletedLegs,
+			dwellUntil:        a.DwellUntil,
+			passedOver:        a.PassedOver,
+			walkingUntil:      a.WalkingUntil,
+			behavior:          DefaultBehavior{},
+			load:              a.Load,
+		}
+	}
+	entered := make([]*actorState, len(in.Entered))
+	for i, e := range in.Entered {
+		entered[i] = &actorState{
+			placeType:  e.PlaceType,
+			placeIndex: e.PlaceIndex,
+			actor:      actors[e.Actor],
+		}
+	}
+
+	s.lock()
+	defer s.unlock()
+	s.tick = in.Tick
+	s.elevators = elevators
+	s.floors = floors
+	s.building = in.Building
+	s.faults = in.Faults
+	s.actors = actors
+	s.enteredActors = entered
+	s.controllerFaultPolicy = in.ControllerFaultPolicy
+	s.controllerFaults = in.ControllerFaults
+	s.disqualification = in.Disqualification
+	s.attachController(factory)
+	return nil
+}
+
+// validate verifies every elevator, floor, leg and actor referenced within the snapshot exists, so a damaged snapshot is
+// rejected by Restore rather than failing once the simulation resumes.
+func (in *simulationSnapshot) validate() error {
+	validFloor := func(floor int) bool {
+		return floor >= 0 && floor < len(in.Floors)
+	}
+	validElevator := func(elevator int) bool {
+		return elevator >= 0 && elevator < len(in.Elevators)
+	}
+	if len(in.Building.Floors) != len(in.Floors) {
+		return fmt.Errorf("snapshot building has %d floors, expected %d", len(in.Building.Floors), len(in.Floors))
+	}
+	for i, f := range in.Faults {
+		if !validElevator(int(f.Elevator)) {
+			return fmt.Errorf("fault %d references unknown elevator %d", i, f.Elevator)
+		}
+	}
+	for i, e := range in.Elevators {
+		floors := []int{e.CurrentFloor, e.MoveToFloor, e.PendingFloor}
+		floors = append(floors, e.DesiredFloors...)
+		floors = append(floors, e.Itinerary...)
+		for _, floor := range floors {
+			if !validFloor(floor) {
+				return fmt.Errorf("elevator %d references unknown floor %d", i, floor)
+			}
+		}
+	}
+	for i, a := range in.Actors {
+		floors := []int{a.StartingFloor, a.FloorGoal, a.LegGoal}
+		for _, leg := range a.Legs {
+			floors = append(floors, leg.Floor)
+		}
+		for _, floor := range floors {
+			if !validFloor(floor) {
+				return fmt.Errorf("actor %d references unknown floor %d", i, floor)
+			}
+		}
+		if a.Leg < 0 || (a.Leg >= len(a.Legs) && !(a.Leg == 0 && len(a.Legs) == 0)) {
+			return fmt.Errorf("actor %d is on leg %d of %d", i, a.Leg, len(a.Legs))
+		}
+		for _, elevators := range [][]int{a.RefusedBy, a.PassedOver} {
+			for _, elevator := range elevators {
+				if !validElevator(elevator) {
+					return fmt.Errorf("actor %d references unknown elevator %d", i, elevator)
+				}
+			}
+		}
+		if a.ActorID < -1 || a.ActorID >= len(in.Entered) {
+			return fmt.Errorf("actor %d references unknown entered actor %d", i, a.ActorID)
+		}
+	}
+	for i, e := range in.Entered {
+		if e.Actor < 0 || e.Actor >= len(in.Actors) {
+			return fmt.Errorf("entered actor %d references unknown actor %d", i, e.Actor)
+		}
+		switch e.PlaceType {
+		case PlaceFloor, PlaceStairs:
+			if !validFloor(e.PlaceIndex) {
+				return fmt.Errorf("entered actor %d references unknown floor %d", i, e.PlaceIndex)
+			}
+		case PlaceElevator:
+			if !validElevator(e.PlaceIndex) {
+				return fmt.Errorf("entered actor %d references unknown elevator %d", i, e.PlaceIndex)
+			}
+		case PlaceOutside:
+		default:
+			return fmt.Errorf("entered actor %d has unknown place type %d", i, e.PlaceType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package simulator
+
+// ElevatorStatus is a point in time view of an elevator for controllers.
+type ElevatorStatus struct {
+	Elevator ElevatorID
+	// Floor is the floor the car is at, or last passed while travelling.
+	Floor FloorID
+	// Direction is the direction the car is travelling, DirectionNone while stopped.
+	Direction Direction
+	// State is one of Idle, MovingUp, MovingDown, DoorsOpening, DoorsOpen or DoorsClosing.
+	State int
+	// Target is the floor the car will stop at next.  Stopped cars target their current floor unless a move is pending.
+	Target FloorID
+	// Riders is the number of actors within the car.
+	Riders int
+	// Capacity is the maximum number of riders the car holds.
+	Capacity int
+	// Load is the combined load of the riders within the car.
+	Load Load
+	// RatedLoad is the maximum combined load the car carries, or zero when the car is only limited by Capacity.
+	RatedLoad Load
+	// CarCalls are the floors selected by riders which the car has yet to stop at, in the order they were selected.
+	CarCalls []FloorID
+	// Itinerary are the stops planned for the car by the controller, in the order they will be made.
+	Itinerary []FloorID
+	// Fault is the reason the car is out of service, or FaultNone.
+	Fault FaultKind
+}
+
+// ObservedElevators provides controllers read-only access to the current state of the elevators.
+type ObservedElevators interface {
+	// Status describes the given elevator.  A *ControllerFaultError is returned for elevators which do not exist.
+	Status(elevatorID ElevatorID) (ElevatorStatus, error)
+	// Statuses describes every elevator, indexed by ElevatorID.
+	Statuses() []ElevatorStatus
+	// Now is the tick being simulated, or the next tick to be simulated when invoked between ticks.
+	Now() Tick
+}
+
+func (s *Simulation) Status(elevatorID ElevatorID) (ElevatorStatus, error) {
+	if err := s.unknownElevator("Status", elevatorID); err != nil {
+		return ElevatorStatus{Elevator: elevatorID}, err
+	}
+	return s.status(elevatorID), nil
+}
+
+// status describes an elevator known to exist.
+func (s *Simulation) status(elevatorID ElevatorID) ElevatorStatus {
+	e := s.elevators[elevatorID]
+	target := e.currentFloor
+	switch {
+	case e.state == MovingUp || e.state == MovingDown:
+		target = e.moveToFloor
+	case e.pendingMove:
+		target = e.pendingFloor
+	case len(e.itinerary) > 0:
+		target = e.itinerary[0]
+	}
+	direction := DirectionNone
+	switch e.travelDirection() {
+	case 1:
+		direction = DirectionUp
+	case -1:
+		direction = DirectionDown
+	}
+	return ElevatorStatus{
+		Elevator:  elevatorID,
+		Floor:     FloorID(e.currentFloor),
+		Direction: direction,
+		State:     e.state,
+		Target:    FloorID(target),
+		Riders:    s.ridersIn(int(elevatorID)),
+		Capacity:  int(e.capacity),
+		Load:      s.loadIn(int(elevatorID)),
+		RatedLoad: e.config.RatedLoad,
+		CarCalls:  floorIDs(e.desiredFloors),
+		Itinerary: floorIDs(e.itinerary),
+		Fault:     e.fault,
+	}
+}
+
+func (s *Simulation) Now() Tick {
+	return s.tick
+}
+
+func (s *Simulation) Statuses() []ElevatorStatus {
+	statuses := make([]ElevatorStatus, len(s.elevators))
+	for i := range s.elevators {
+		statuses[i] = s.status(ElevatorID(i))
+	}
+	return statuses
+}
+
+func floorIDs(floors []int) []FloorID {
+	ids := make([]FloorID, len(floors))
+	for i, floor := range floors {
+		ids[i] = FloorID(floor)
+	}
+	return ids
+}
@@ -2,4 +2,5 @@
 
 protoc --go_out=. --go_opt=paths=source_relative \
     --go-grpc_out=. --go-grpc_opt=paths=source_relative \
-    pkg/ipc/grpc/telepathy/pb/telepathy.proto
+    pkg/ipc/grpc/telepathy/pb/telepathy.proto \
+    pkg/eventio/pb/events.proto